@@ -41,7 +41,7 @@ func dataSourceDatadogApiKey() *schema.Resource {
 func dataSourceDatadogApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if id := d.Get("id").(string); id != "" {
 		resp, httpResponse, err := apiInstances.GetKeyManagementApiV2().GetAPIKey(auth, id)
@@ -0,0 +1,67 @@
+package datadog
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceDatadogApiRequest() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to send a GET request to any Datadog API endpoint and retrieve the JSON response, e.g. for endpoints that don't have a dedicated data source yet.",
+		ReadContext: dataSourceDatadogApiRequestRead,
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Description:  "The path of the API endpoint, e.g. `/api/v2/roles`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidateAPIPath,
+			},
+			"query": {
+				Description: "The query parameters of the request.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed values
+			"response_body": {
+				Description: "The JSON body of the response. Use `jsondecode` to access its fields.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceDatadogApiRequestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	path := d.Get("path").(string)
+	query := url.Values{}
+	for k, v := range d.Get("query").(map[string]interface{}) {
+		query.Set(k, v.(string))
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	respByte, httpResp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", path, nil)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpResp, "error sending request to "+path)
+	}
+
+	if err := d.Set("response_body", string(respByte)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(path)
+
+	return nil
+}
@@ -41,7 +41,7 @@ func dataSourceDatadogApplicationKey() *schema.Resource {
 func dataSourceDatadogApplicationKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if id := d.Get("id").(string); id != "" {
 		resp, httpResponse, err := apiInstances.GetKeyManagementApiV2().GetCurrentUserApplicationKey(auth, id)
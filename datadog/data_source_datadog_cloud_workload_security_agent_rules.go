@@ -57,7 +57,7 @@ func dataSourceDatadogCloudWorkloadSecurityAgentRules() *schema.Resource {
 func dataSourceDatadogCloudWorkloadSecurityAgentRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	agentRules := make([]map[string]interface{}, 0)
 
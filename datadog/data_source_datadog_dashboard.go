@@ -43,8 +43,9 @@ func dataSourceDatadogDashboard() *schema.Resource {
 func dataSourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
+	var diags diag.Diagnostics
 	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutRead), func() *resource.RetryError {
 		dashResponse, httpresp, err := apiInstances.GetDashboardsApiV1().ListDashboards(auth)
 		if err != nil {
@@ -53,9 +54,8 @@ func dataSourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData,
 			}
 			return resource.NonRetryableError(utils.TranslateClientError(err, httpresp, "error querying dashboard"))
 		}
-		if err := utils.CheckForUnparsed(dashResponse); err != nil {
-			return resource.NonRetryableError(err)
-		}
+		// Only the summary fields are used, don't fail on unparsed elements
+		diags = utils.CheckForUnparsedDiag(dashResponse)
 
 		searchedName := d.Get("name")
 		var foundDashes []datadogV1.DashboardSummaryDefinition
@@ -82,5 +82,5 @@ func dataSourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diags
 }
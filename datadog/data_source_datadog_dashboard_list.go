@@ -31,7 +31,7 @@ func dataSourceDatadogDashboardList() *schema.Resource {
 func dataSourceDatadogDashboardListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	listResponse, httpresp, err := apiInstances.GetDashboardListsApiV1().ListDashboardLists(auth)
 	if err != nil {
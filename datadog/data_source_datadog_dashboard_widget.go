@@ -0,0 +1,53 @@
+package datadog
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func dataSourceDatadogDashboardWidget() *schema.Resource {
+	widgetSchema := getWidgetSchema()
+	// The widget ID is set by the dashboard the widget is added to, and the unparsed elements are read from it
+	delete(widgetSchema, "id")
+	delete(widgetSchema, "unparsed_elements_json")
+	widgetSchema["json"] = &schema.Schema{
+		Description: "The JSON formatted widget, to use in the `widget_json` of a `datadog_dashboard` resource or in the `widgets` of a `datadog_dashboard_json` resource.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Use this data source to render a dashboard widget defined with the same blocks as the `widget` of a `datadog_dashboard` resource to its JSON definition, e.g. to share widgets between dashboards in modules.",
+		ReadContext: dataSourceDatadogDashboardWidgetRead,
+		Schema:      widgetSchema,
+	}
+}
+
+func dataSourceDatadogDashboardWidgetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	terraformWidget := make(map[string]interface{})
+	for k := range getWidgetSchema() {
+		if k != "id" && k != "unparsed_elements_json" {
+			terraformWidget[k] = d.Get(k)
+		}
+	}
+
+	datadogWidget, err := buildDatadogWidget(terraformWidget)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	widgetJSON, err := buildWidgetJSON(*datadogWidget)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(utils.ConvertToSha256(widgetJSON))
+	if err := d.Set("json", widgetJSON); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
@@ -133,7 +133,7 @@ func dataSourceDatadogIPRanges() *schema.Resource {
 func dataSourceDatadogIPRangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ipAddresses, _, err := apiInstances.GetIPRangesApiV1().GetIPRanges(auth)
 	if err != nil {
@@ -29,7 +29,7 @@ func dataSourceDatadogLogsArchivesOrder() *schema.Resource {
 func dataSourceDatadogLogsArchivesOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logsArchiveOrder, httpresp, err := apiInstances.GetLogsArchivesApiV2().GetLogsArchiveOrder(auth)
 	if err != nil {
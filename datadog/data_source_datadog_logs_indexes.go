@@ -99,7 +99,7 @@ var dataSourceLogsIndexesExclusionFilterSchema = map[string]*schema.Schema{
 func dataSourceDatadogLogsIndexesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logsIndexes, httpresp, err := apiInstances.GetLogsIndexesApiV1().ListLogIndexes(auth)
 	if err != nil {
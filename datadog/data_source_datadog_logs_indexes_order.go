@@ -29,7 +29,7 @@ func dataSourceDatadogLogsIndexesOrder() *schema.Resource {
 func dataSourceDatadogLogsIndexesOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logsIndexesOrder, httpresp, err := apiInstances.GetLogsIndexesApiV1().GetLogsIndexOrder(auth)
 	if err != nil {
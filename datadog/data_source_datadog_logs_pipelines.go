@@ -87,7 +87,7 @@ func buildTerraformLogsPipelineFilter(ddFilter datadogV1.LogsFilter) *[]map[stri
 func dataSourceDatadogLogsPipelinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	logsPipelines, httpresp, err := apiInstances.GetLogsPipelinesApiV1().ListLogsPipelines(auth)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error querying log pipelines")
@@ -277,7 +277,7 @@ func dataSourceDatadogMonitor() *schema.Resource {
 func dataSourceDatadogMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	optionalParams := datadogV1.NewListMonitorsOptionalParameters()
 	if v, ok := d.GetOk("name_filter"); ok {
@@ -68,7 +68,7 @@ func dataSourceDatadogMonitorConfigPolicies() *schema.Resource {
 func dataSourceDatadogMonitorConfigPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	monitorConfigPolicies, httpresp, err := apiInstances.GetMonitorsApiV2().ListMonitorConfigPolicies(auth)
 	if err != nil {
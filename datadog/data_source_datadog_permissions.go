@@ -37,7 +37,7 @@ func dataSourceDatadogPermissions() *schema.Resource {
 func dataSourceDatadogPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	res, resp, err := apiInstances.GetRolesApiV2().ListPermissions(auth)
 	if err != nil {
@@ -41,7 +41,7 @@ func dataSourceDatadogRole() *schema.Resource {
 func dataSourceDatadogRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	optionalParams := datadogV2.NewListRolesOptionalParameters()
 	filter := d.Get("filter").(string)
@@ -53,7 +53,7 @@ func dataSourceDatadogRUMApplication() *schema.Resource {
 func dataSourceDatadogRUMApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if searchID, ok := d.GetOk("id"); ok {
 		resp, _, err := apiInstances.GetRumApiV2().GetRUMApplication(auth, searchID.(string))
@@ -38,7 +38,7 @@ func dataSourceDatadogSecurityMonitoringFilters() *schema.Resource {
 func dataSourceDatadogSecurityFiltersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	filterIds := make([]string, 0)
 	filters := make([]map[string]interface{}, 0)
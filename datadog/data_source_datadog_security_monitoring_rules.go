@@ -70,7 +70,7 @@ func dataSourceDatadogSecurityMonitoringRules() *schema.Resource {
 func dataSourceDatadogSecurityMonitoringRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	var nameFilter *string
 	var defaultFilter *bool
@@ -54,7 +54,7 @@ func dataSourceDatadogServiceLevelObjective() *schema.Resource {
 func dataSourceDatadogServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	reqParams := datadogV1.NewListSLOsOptionalParameters()
 	if v, ok := d.GetOk("id"); ok {
@@ -74,7 +74,7 @@ func dataSourceDatadogServiceLevelObjectiveRead(ctx context.Context, d *schema.R
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error querying service level objectives")
 	}
-	if err := utils.CheckForUnparsed(slosResp); err != nil {
+	if err := parseTimeSliceSLOsListResponse(&slosResp); err != nil {
 		return diag.FromErr(err)
 	}
 	if len(slosResp.GetData()) > 1 {
@@ -0,0 +1,286 @@
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func dataSourceDatadogServiceLevelObjectiveHistory() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve the status of an SLO over a time window, e.g. to block deployments once its error budget is exhausted.",
+		ReadContext: dataSourceDatadogServiceLevelObjectiveHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"slo_id": {
+				Description: "The ID of the service level objective.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"window": {
+				Description:  "The time window ending now, e.g. `7d` or `24h`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"window", "from"},
+				ValidateFunc: validateSLOAlertWindow,
+			},
+			"from": {
+				Description:  "The start of the time window, as an RFC3339 date.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+			},
+			"to": {
+				Description:   "The end of the time window, as an RFC3339 date. Defaults to now.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"window"},
+				ValidateFunc:  validation.IsRFC3339Time,
+			},
+			"groups": {
+				Description: "The groups to return the status of. Defaults to all the groups of the SLO.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"target": {
+				Description: "The target the error budget is computed from. Defaults to the target of the SLO threshold whose time frame is `window`, or to the target of the primary time frame of the SLO.",
+				Type:        schema.TypeFloat,
+				Optional:    true,
+				Computed:    true,
+			},
+			"apply_correction": {
+				Description: "Whether to apply the status corrections of the SLO.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+
+			// Computed values
+			"from_ts": {
+				Description: "The start of the time window, as a Unix timestamp.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"to_ts": {
+				Description: "The end of the time window, as a Unix timestamp.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"sli_value": {
+				Description: "The overall SLI over the time window, in percent.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"error_budget_remaining": {
+				Description: "The percentage of the error budget remaining over the time window. It is negative once the error budget is exhausted.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"burn_rate": {
+				Description: "The rate the error budget was consumed at over the time window, `1` consuming exactly the error budget.",
+				Type:        schema.TypeFloat,
+				Computed:    true,
+			},
+			"group": {
+				Description: "The status of each group of the SLO.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the group.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sli_value": {
+							Description: "The SLI of the group over the time window, in percent.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"error_budget_remaining": {
+							Description: "The percentage of the error budget of the group remaining over the time window.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+						"burn_rate": {
+							Description: "The rate the error budget of the group was consumed at over the time window.",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogServiceLevelObjectiveHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	sloID := d.Get("slo_id").(string)
+	to := providerConf.Now()
+	var from time.Time
+	if window, ok := d.GetOk("window"); ok {
+		duration, err := utils.ParseSLOAlertWindow(window.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		from = to.Add(-duration)
+	} else {
+		from, _ = time.Parse(time.RFC3339, d.Get("from").(string))
+		if v, ok := d.GetOk("to"); ok {
+			to, _ = time.Parse(time.RFC3339, v.(string))
+		}
+	}
+	if !from.Before(to) {
+		return diag.Errorf("the start of the time window %s must be before its end %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+	}
+
+	target, ok := d.GetOk("target")
+	if !ok {
+		sloResp, httpresp, err := apiInstances.GetServiceLevelObjectivesApiV1().GetSLO(auth, sloID)
+		if err != nil {
+			return utils.TranslateClientErrorDiag(err, httpresp, "error getting service level objective")
+		}
+		if _, err := parseTimeSliceSLOResponse(&sloResp); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := utils.CheckForUnparsed(sloResp); err != nil {
+			return diag.FromErr(err)
+		}
+		if target, ok = sloHistoryDefaultTarget(sloResp.GetData(), d.Get("window").(string)); !ok {
+			return diag.Errorf("service level objective %s has no target, set `target`", sloID)
+		}
+	}
+
+	optionalParams := datadogV1.NewGetSLOHistoryOptionalParameters().
+		WithTarget(target.(float64)).
+		WithApplyCorrection(d.Get("apply_correction").(bool))
+	resp, httpresp, err := apiInstances.GetServiceLevelObjectivesApiV1().GetSLOHistory(auth, sloID, from.Unix(), to.Unix(), *optionalParams)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting service level objective history")
+	}
+	if err := parseTimeSliceSLOHistoryResponse(&resp); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	data := resp.GetData()
+	overall := data.GetOverall()
+	sli, ok := overall.GetSliValueOk()
+	if !ok {
+		var errs []string
+		for _, e := range resp.GetErrors() {
+			errs = append(errs, e.GetError())
+		}
+		for _, e := range overall.GetErrors() {
+			errs = append(errs, e.GetErrorMessage())
+		}
+		return diag.Errorf("no SLI for service level objective %s between %s and %s: %s", sloID, from.Format(time.RFC3339), to.Format(time.RFC3339), strings.Join(errs, "; "))
+	}
+
+	var filter map[string]bool
+	if groups := d.Get("groups").([]interface{}); len(groups) > 0 {
+		filter = make(map[string]bool)
+		for _, group := range groups {
+			filter[group.(string)] = true
+		}
+	}
+	groups := make([]map[string]interface{}, 0)
+	for _, group := range data.GetGroups() {
+		name := group.GetGroup()
+		if name == "" {
+			name = group.GetName()
+		}
+		groupSLI, ok := group.GetSliValueOk()
+		if !ok || (filter != nil && !filter[name]) {
+			continue
+		}
+		groups = append(groups, map[string]interface{}{
+			"name":                   name,
+			"sli_value":              *groupSLI,
+			"error_budget_remaining": utils.SLOErrorBudgetRemaining(*groupSLI, target.(float64)),
+			"burn_rate":              utils.SLOBurnRate(*groupSLI, target.(float64)),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d:%d", sloID, from.Unix(), to.Unix()))
+	if err := d.Set("target", target); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("from_ts", from.Unix()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("to_ts", to.Unix()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sli_value", *sli); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("error_budget_remaining", utils.SLOErrorBudgetRemaining(*sli, target.(float64))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("burn_rate", utils.SLOBurnRate(*sli, target.(float64))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("group", groups); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// sloHistoryDefaultTarget returns the target of the SLO threshold over window, or the target of its primary
+// time frame
+func sloHistoryDefaultTarget(slo datadogV1.SLOResponseData, window string) (float64, bool) {
+	for _, threshold := range slo.GetThresholds() {
+		if string(threshold.GetTimeframe()) == window {
+			return threshold.GetTarget(), true
+		}
+	}
+	if target, ok := slo.GetTargetThresholdOk(); ok {
+		return *target, true
+	}
+	for _, threshold := range slo.GetThresholds() {
+		if threshold.GetTimeframe() == slo.GetTimeframe() {
+			return threshold.GetTarget(), true
+		}
+	}
+	if len(slo.GetThresholds()) > 0 {
+		return slo.GetThresholds()[0].GetTarget(), true
+	}
+	return 0, false
+}
+
+// parseTimeSliceSLOHistoryResponse decodes the history of a time-slice SLO, returned as an unparsed object
+func parseTimeSliceSLOHistoryResponse(resp *datadogV1.SLOHistoryResponse) error {
+	if resp.Data == nil || resp.Data.UnparsedObject == nil || resp.Data.UnparsedObject["type"] != string(sloTypeTimeSlice) {
+		return nil
+	}
+	raw := make(map[string]interface{}, len(resp.Data.UnparsedObject))
+	for k, v := range resp.Data.UnparsedObject {
+		raw[k] = v
+	}
+	delete(raw, "type")
+	delete(raw, "type_id")
+	parsed := datadogV1.SLOHistoryResponseData{}
+	if err := remarshal(raw, &parsed); err != nil {
+		return err
+	}
+	parsed.SetType(sloTypeTimeSlice)
+	resp.Data = &parsed
+	resp.UnparsedObject = nil
+	return nil
+}
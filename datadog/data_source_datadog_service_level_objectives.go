@@ -73,7 +73,7 @@ func dataSourceDatadogServiceLevelObjectives() *schema.Resource {
 func dataSourceDatadogServiceLevelObjectivesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	var idsPtr *string
 	var nameQueryPtr *string
@@ -106,7 +106,7 @@ func dataSourceDatadogServiceLevelObjectivesRead(ctx context.Context, d *schema.
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error querying service level objectives")
 	}
-	if err := utils.CheckForUnparsed(slosResp); err != nil {
+	if err := parseTimeSliceSLOsListResponse(&slosResp); err != nil {
 		return diag.FromErr(err)
 	}
 	if len(slosResp.GetData()) == 0 {
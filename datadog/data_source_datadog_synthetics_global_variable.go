@@ -38,7 +38,7 @@ func dataSourceDatadogSyntheticsGlobalVariableRead(ctx context.Context, d *schem
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	globalVariables, httpresp, err := apiInstances.GetSyntheticsApiV1().ListGlobalVariables(auth)
 	if err != nil {
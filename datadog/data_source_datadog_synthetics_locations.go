@@ -29,7 +29,7 @@ func dataSourceDatadogSyntheticsLocationsRead(ctx context.Context, d *schema.Res
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	syntheticsLocations, _, err := apiInstances.GetSyntheticsApiV1().ListLocations(auth)
 
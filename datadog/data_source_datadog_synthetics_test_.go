@@ -45,7 +45,7 @@ func dataSourceDatadogSyntheticsTest() *schema.Resource {
 func dataSourceDatadogSyntheticsTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	tests, httpresp, err := apiInstances.GetSyntheticsApiV1().ListTests(auth)
 	if err != nil {
@@ -0,0 +1,384 @@
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+// ExportOptions selects the objects written by Export
+type ExportOptions struct {
+	// ResourceTypes restricts the export to these resource types, e.g. `datadog_monitor`. All the supported
+	// types are exported when empty.
+	ResourceTypes []string
+	// Tags restricts the export to the objects with all of these tags, e.g. `team:web`. Objects without
+	// tags, such as dashboards or roles, are skipped when set.
+	Tags []string
+}
+
+type exportedObject struct {
+	id   string
+	name string
+	tags []string
+}
+
+type resourceExporter struct {
+	resourceType string
+	list         func(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error)
+}
+
+// resourceExporters lists the exported resource types, referenced resource types first
+var resourceExporters = []resourceExporter{
+	{"datadog_role", listExportedRoles},
+	{"datadog_user", listExportedUsers},
+	{"datadog_monitor", listExportedMonitors},
+	{"datadog_service_level_objective", listExportedServiceLevelObjectives},
+	{"datadog_dashboard", listExportedDashboards},
+	{"datadog_synthetics_test", listExportedSyntheticsTests},
+	{"datadog_logs_custom_pipeline", listExportedLogsCustomPipelines},
+	{"datadog_security_monitoring_rule", listExportedSecurityMonitoringRules},
+}
+
+// exportReferences maps the attributes holding the IDs of other objects to the referenced resource type
+var exportReferences = map[string]map[string]string{
+	"datadog_service_level_objective": {"monitor_ids": "datadog_monitor"},
+	"datadog_user":                    {"roles": "datadog_role"},
+}
+
+// ExportResourceTypes returns the resource types supported by Export
+func ExportResourceTypes() []string {
+	resourceTypes := make([]string, 0, len(resourceExporters))
+	for _, exporter := range resourceExporters {
+		resourceTypes = append(resourceTypes, exporter.resourceType)
+	}
+	return resourceTypes
+}
+
+type exportedResource struct {
+	resourceType string
+	name         string
+	data         *schema.ResourceData
+}
+
+// Export reads the existing objects with the provider configured from the environment, and writes them as
+// Terraform configuration along with the `import` blocks bringing them under management. The resources are
+// read with their own `Read` function, so the configuration matches the state after import.
+func Export(ctx context.Context, w io.Writer, opts ExportOptions) error {
+	selectedTypes := make(map[string]bool)
+	for _, resourceType := range opts.ResourceTypes {
+		if !isExportedResourceType(resourceType) {
+			return fmt.Errorf("unsupported resource type %s, supported types are %v", resourceType, ExportResourceTypes())
+		}
+		selectedTypes[resourceType] = true
+	}
+
+	provider := Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{})); diags.HasError() {
+		return fmt.Errorf("error configuring the provider: %s", diagsToString(diags))
+	}
+	providerConf := provider.Meta().(*ProviderConfiguration)
+
+	var resources []exportedResource
+	addresses := make(map[string]map[string]string)
+	usedNames := make(map[string]map[string]bool)
+	for _, exporter := range resourceExporters {
+		if len(selectedTypes) > 0 && !selectedTypes[exporter.resourceType] {
+			continue
+		}
+		objects, err := exporter.list(ctx, providerConf)
+		if err != nil {
+			return err
+		}
+		res := provider.ResourcesMap[exporter.resourceType]
+		addresses[exporter.resourceType] = make(map[string]string)
+		usedNames[exporter.resourceType] = make(map[string]bool)
+		for _, object := range objects {
+			if !hasAllTags(object.tags, opts.Tags) {
+				continue
+			}
+			d, err := readExportedResource(ctx, res, object.id, providerConf)
+			if err != nil {
+				log.Printf("[WARN] skipping %s %s: %s", exporter.resourceType, object.id, err)
+				continue
+			}
+			name := utils.ExportResourceName(object.name, object.id, usedNames[exporter.resourceType])
+			addresses[exporter.resourceType][d.Id()] = fmt.Sprintf("%s.%s.id", exporter.resourceType, name)
+			resources = append(resources, exportedResource{exporter.resourceType, name, d})
+		}
+	}
+
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	for i, exported := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", utils.ExportTraversal(exported.resourceType+"."+exported.name))
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(exported.data.Id()))
+		body.AppendNewline()
+
+		references := make(map[string]map[string]string)
+		for k, referencedType := range exportReferences[exported.resourceType] {
+			references[k] = addresses[referencedType]
+		}
+		res := provider.ResourcesMap[exported.resourceType]
+		values := make(map[string]interface{}, len(res.Schema))
+		for k := range res.Schema {
+			values[k] = exported.data.Get(k)
+		}
+		block := body.AppendNewBlock("resource", []string{exported.resourceType, exported.name})
+		utils.WriteResourceBody(block.Body(), res.Schema, values, references)
+	}
+
+	_, err := f.WriteTo(w)
+	return err
+}
+
+func isExportedResourceType(resourceType string) bool {
+	for _, exporter := range resourceExporters {
+		if exporter.resourceType == resourceType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasAllTags(tags []string, expected []string) bool {
+	for _, e := range expected {
+		found := false
+		for _, tag := range tags {
+			if tag == e {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// readExportedResource reads an object the way `terraform import` does. Attributes which aren't set by
+// Read, such as `validate`, keep their default so they aren't exported.
+func readExportedResource(ctx context.Context, res *schema.Resource, id string, providerConf *ProviderConfiguration) (*schema.ResourceData, error) {
+	d := res.Data(nil)
+	for k, s := range res.Schema {
+		if s.Default != nil {
+			if err := d.Set(k, s.Default); err != nil {
+				return nil, err
+			}
+		}
+	}
+	d.SetId(id)
+	diags := res.ReadContext(ctx, d, providerConf)
+	for _, diagnostic := range diags {
+		log.Printf("[WARN] %s: %s", id, diagnostic.Summary)
+	}
+	if diags.HasError() {
+		return nil, fmt.Errorf("error reading the resource: %s", diagsToString(diags))
+	}
+	if d.Id() == "" {
+		return nil, fmt.Errorf("the resource no longer exists")
+	}
+	return d, nil
+}
+
+func diagsToString(diags diag.Diagnostics) string {
+	var summaries []string
+	for _, diagnostic := range diags {
+		if diagnostic.Severity == diag.Error {
+			summaries = append(summaries, diagnostic.Summary)
+		}
+	}
+	return strings.Join(summaries, ", ")
+}
+
+func listExportedRoles(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var objects []exportedObject
+	pageSize := int64(100)
+	for page := int64(0); ; page++ {
+		optionalParams := datadogV2.NewListRolesOptionalParameters().WithPageSize(pageSize).WithPageNumber(page)
+		resp, httpresp, err := apiInstances.GetRolesApiV2().ListRoles(auth, *optionalParams)
+		if err != nil {
+			return nil, utils.TranslateClientError(err, httpresp, "error listing roles")
+		}
+		for _, role := range resp.GetData() {
+			attributes := role.GetAttributes()
+			objects = append(objects, exportedObject{id: role.GetId(), name: attributes.GetName()})
+		}
+		if len(resp.GetData()) < int(pageSize) {
+			return objects, nil
+		}
+	}
+}
+
+func listExportedUsers(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var objects []exportedObject
+	pageSize := int64(100)
+	for page := int64(0); ; page++ {
+		optionalParams := datadogV2.NewListUsersOptionalParameters().WithPageSize(pageSize).WithPageNumber(page)
+		resp, httpresp, err := apiInstances.GetUsersApiV2().ListUsers(auth, *optionalParams)
+		if err != nil {
+			return nil, utils.TranslateClientError(err, httpresp, "error listing users")
+		}
+		for _, user := range resp.GetData() {
+			attributes := user.GetAttributes()
+			// Service accounts are managed with the datadog_service_account resource
+			if attributes.GetServiceAccount() {
+				continue
+			}
+			objects = append(objects, exportedObject{id: user.GetId(), name: attributes.GetEmail()})
+		}
+		if len(resp.GetData()) < int(pageSize) {
+			return objects, nil
+		}
+	}
+}
+
+func listExportedMonitors(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var objects []exportedObject
+	pageSize := int32(1000)
+	for page := int64(0); ; page++ {
+		optionalParams := datadogV1.NewListMonitorsOptionalParameters().WithPage(page).WithPageSize(pageSize)
+		monitors, httpresp, err := apiInstances.GetMonitorsApiV1().ListMonitors(auth, *optionalParams)
+		if err != nil {
+			return nil, utils.TranslateClientError(err, httpresp, "error listing monitors")
+		}
+		for _, m := range monitors {
+			// Synthetics monitors are managed by their test
+			if m.GetType() == datadogV1.MONITORTYPE_SYNTHETICS_ALERT {
+				continue
+			}
+			objects = append(objects, exportedObject{id: strconv.FormatInt(m.GetId(), 10), name: m.GetName(), tags: m.GetTags()})
+		}
+		if len(monitors) < int(pageSize) {
+			return objects, nil
+		}
+	}
+}
+
+func listExportedServiceLevelObjectives(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var objects []exportedObject
+	limit := int64(1000)
+	for offset := int64(0); ; offset += limit {
+		optionalParams := datadogV1.NewListSLOsOptionalParameters().WithLimit(limit).WithOffset(offset)
+		resp, httpresp, err := apiInstances.GetServiceLevelObjectivesApiV1().ListSLOs(auth, *optionalParams)
+		if err != nil {
+			return nil, utils.TranslateClientError(err, httpresp, "error listing service level objectives")
+		}
+		for _, slo := range resp.GetData() {
+			parsed := datadogV1.ServiceLevelObjective{}
+			if _, ok, err := parseTimeSliceSLO(slo.UnparsedObject, &parsed); err != nil {
+				return nil, err
+			} else if ok {
+				slo = parsed
+			}
+			objects = append(objects, exportedObject{id: slo.GetId(), name: slo.GetName(), tags: slo.GetTags()})
+		}
+		if len(resp.GetData()) < int(limit) {
+			return objects, nil
+		}
+	}
+}
+
+func listExportedDashboards(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	resp, httpresp, err := apiInstances.GetDashboardsApiV1().ListDashboards(auth)
+	if err != nil {
+		return nil, utils.TranslateClientError(err, httpresp, "error listing dashboards")
+	}
+	var objects []exportedObject
+	for _, dashboard := range resp.GetDashboards() {
+		objects = append(objects, exportedObject{id: dashboard.GetId(), name: dashboard.GetTitle()})
+	}
+	return objects, nil
+}
+
+func listExportedSyntheticsTests(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	resp, httpresp, err := apiInstances.GetSyntheticsApiV1().ListTests(auth)
+	if err != nil {
+		return nil, utils.TranslateClientError(err, httpresp, "error listing synthetics tests")
+	}
+	var objects []exportedObject
+	for _, test := range resp.GetTests() {
+		objects = append(objects, exportedObject{id: test.GetPublicId(), name: test.GetName(), tags: test.GetTags()})
+	}
+	return objects, nil
+}
+
+func listExportedLogsCustomPipelines(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	pipelines, httpresp, err := apiInstances.GetLogsPipelinesApiV1().ListLogsPipelines(auth)
+	if err != nil {
+		return nil, utils.TranslateClientError(err, httpresp, "error listing logs pipelines")
+	}
+	var objects []exportedObject
+	for _, pipeline := range pipelines {
+		// Integration pipelines are read-only
+		if pipeline.GetIsReadOnly() {
+			continue
+		}
+		objects = append(objects, exportedObject{id: pipeline.GetId(), name: pipeline.GetName()})
+	}
+	return objects, nil
+}
+
+func listExportedSecurityMonitoringRules(ctx context.Context, providerConf *ProviderConfiguration) ([]exportedObject, error) {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var objects []exportedObject
+	pageSize := int64(100)
+	for page := int64(0); ; page++ {
+		optionalParams := datadogV2.NewListSecurityMonitoringRulesOptionalParameters().WithPageSize(pageSize).WithPageNumber(page)
+		resp, httpresp, err := apiInstances.GetSecurityMonitoringApiV2().ListSecurityMonitoringRules(auth, *optionalParams)
+		if err != nil {
+			return nil, utils.TranslateClientError(err, httpresp, "error listing security monitoring rules")
+		}
+		for _, ruleR := range resp.GetData() {
+			// Default rules are managed with the datadog_security_monitoring_default_rule resource
+			if rule := ruleR.SecurityMonitoringStandardRuleResponse; rule != nil && !rule.GetIsDefault() {
+				objects = append(objects, exportedObject{id: rule.GetId(), name: rule.GetName(), tags: rule.GetTags()})
+			} else if rule := ruleR.SecurityMonitoringSignalRuleResponse; rule != nil && !rule.GetIsDefault() {
+				objects = append(objects, exportedObject{id: rule.GetId(), name: rule.GetName(), tags: rule.GetTags()})
+			}
+		}
+		if len(resp.GetData()) < int(pageSize) {
+			return objects, nil
+		}
+	}
+}
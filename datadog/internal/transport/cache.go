@@ -0,0 +1,173 @@
+package transport
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// cachedResponse holds a copy of a response, so that a new http.Response can be built for every reader
+type cachedResponse struct {
+	status     string
+	statusCode int
+	proto      string
+	header     http.Header
+	body       []byte
+	family     string
+	expiresAt  time.Time
+}
+
+func (r *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         r.proto,
+		Header:        r.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// cacheCall is an in-flight request that identical concurrent requests wait for
+type cacheCall struct {
+	done chan struct{}
+	resp *cachedResponse
+	err  error
+	// canceled is set when the request failed because of the context of the request which sent it,
+	// the requests waiting for it have to send their own
+	canceled bool
+}
+
+// CacheTransport caches the successful responses of GET requests for a given TTL. Identical concurrent
+// requests are only sent once, and any other request to an endpoint family, e.g. `/api/v2/roles`,
+// invalidates the cached responses of this family.
+type CacheTransport struct {
+	defaultTransport http.RoundTripper
+	ttl              time.Duration
+
+	mu       sync.Mutex
+	entries  map[string]*cachedResponse
+	inFlight map[string]*cacheCall
+	// generations is bumped on every write to an endpoint family, so that a GET sent before the
+	// write completes doesn't store a stale response
+	generations map[string]uint64
+
+	now func() time.Time
+}
+
+// NewCacheTransport returns new CacheTransport struct
+func NewCacheTransport(t http.RoundTripper, ttl time.Duration) *CacheTransport {
+	// Use default transport if one provided is nil
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	return &CacheTransport{
+		defaultTransport: t,
+		ttl:              ttl,
+		entries:          make(map[string]*cachedResponse),
+		inFlight:         make(map[string]*cacheCall),
+		generations:      make(map[string]uint64),
+		now:              time.Now,
+	}
+}
+
+// RoundTrip method used to serve GET requests from the cache
+func (t *CacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	family := endpointFamily(req)
+	if req.Method != http.MethodGet {
+		t.invalidate(family)
+		resp, err := t.defaultTransport.RoundTrip(req)
+		// Invalidate again in case a concurrent GET cached the state from before the write
+		t.invalidate(family)
+		return resp, err
+	}
+
+	key := req.URL.String()
+	for {
+		t.mu.Lock()
+		if entry, ok := t.entries[key]; ok {
+			if t.now().Before(entry.expiresAt) {
+				t.mu.Unlock()
+				log.Printf("[DEBUG] Serving GET %s from the cache", req.URL.Path)
+				return entry.response(req), nil
+			}
+			delete(t.entries, key)
+		}
+		call, ok := t.inFlight[key]
+		if !ok {
+			break
+		}
+		t.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		if call.err == nil {
+			return call.resp.response(req), nil
+		}
+		if !call.canceled {
+			return nil, call.err
+		}
+		// The request waited for was canceled by its sender, send this one instead
+	}
+	call := &cacheCall{done: make(chan struct{})}
+	t.inFlight[key] = call
+	generation := t.generations[family]
+	t.mu.Unlock()
+
+	call.resp, call.err = t.fetch(req, family)
+	call.canceled = call.err != nil && req.Context().Err() != nil
+
+	t.mu.Lock()
+	delete(t.inFlight, key)
+	if call.err == nil && call.resp.statusCode == http.StatusOK && t.generations[family] == generation {
+		t.entries[key] = call.resp
+	}
+	t.mu.Unlock()
+	close(call.done)
+
+	if call.err != nil {
+		return nil, call.err
+	}
+	return call.resp.response(req), nil
+}
+
+func (t *CacheTransport) fetch(req *http.Request, family string) (*cachedResponse, error) {
+	resp, err := t.defaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedResponse{
+		status:     resp.Status,
+		statusCode: resp.StatusCode,
+		proto:      resp.Proto,
+		header:     resp.Header,
+		body:       body,
+		family:     family,
+		expiresAt:  t.now().Add(t.ttl),
+	}, nil
+}
+
+func (t *CacheTransport) invalidate(family string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generations[family]++
+	for key, entry := range t.entries {
+		if entry.family == family {
+			delete(t.entries, key)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newCacheTestTransport(statusCode int) (*CacheTransport, *int32, *time.Time) {
+	var calls int32
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		return &http.Response{StatusCode: statusCode, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(`{"data":[]}`))}, nil
+	})
+	now := time.Unix(0, 0)
+	ct := NewCacheTransport(rt, time.Minute)
+	ct.now = func() time.Time { return now }
+	return ct, &calls, &now
+}
+
+func roundTripBody(t *testing.T, ct http.RoundTripper, method, path string) {
+	resp, err := ct.RoundTrip(newTestRequest(method, path))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if body, _ := ioutil.ReadAll(resp.Body); string(body) != `{"data":[]}` {
+		t.Errorf("unexpected body '%s'", body)
+	}
+}
+
+func TestCacheTransport(t *testing.T) {
+	cases := map[string]struct {
+		statusCode int
+		requests   [][2]string
+		wait       time.Duration
+		calls      int32
+	}{
+		"cached":              {200, [][2]string{{"GET", "/api/v2/roles"}, {"GET", "/api/v2/roles"}}, 0, 1},
+		"different url":       {200, [][2]string{{"GET", "/api/v2/roles"}, {"GET", "/api/v2/roles/abc"}}, 0, 2},
+		"expired":             {200, [][2]string{{"GET", "/api/v2/roles"}, {"GET", "/api/v2/roles"}}, 2 * time.Minute, 2},
+		"errors not cached":   {404, [][2]string{{"GET", "/api/v2/roles/abc"}, {"GET", "/api/v2/roles/abc"}}, 0, 2},
+		"write invalidates":   {200, [][2]string{{"GET", "/api/v2/roles"}, {"POST", "/api/v2/roles/abc/permissions"}, {"GET", "/api/v2/roles"}}, 0, 3},
+		"other family intact": {200, [][2]string{{"GET", "/api/v2/roles"}, {"POST", "/api/v2/users"}, {"GET", "/api/v2/roles"}}, 0, 2},
+	}
+	for name, tc := range cases {
+		ct, calls, now := newCacheTestTransport(tc.statusCode)
+		for i, r := range tc.requests {
+			if i > 0 {
+				*now = now.Add(tc.wait)
+			}
+			roundTripBody(t, ct, r[0], r[1])
+		}
+		if *calls != tc.calls {
+			t.Errorf("%s: expected %d calls, got %d", name, tc.calls, *calls)
+		}
+	}
+}
+
+func TestCacheTransportSingleFlight(t *testing.T) {
+	release := make(chan struct{})
+	var calls int32
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&calls, 1)
+		<-release
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(`{"data":[]}`))}, nil
+	})
+	ct := NewCacheTransport(rt, time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			roundTripBody(t, ct, "GET", "/api/v2/permissions")
+		}()
+	}
+	// Let the goroutines pile up on the in-flight request
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("expected concurrent requests to be sent once, got %d calls", calls)
+	}
+}
+
+func TestCacheTransportSingleFlightWaiterCanceled(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		<-release
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(`{"data":[]}`))}, nil
+	})
+	ct := NewCacheTransport(rt, time.Minute)
+
+	go ct.RoundTrip(newTestRequest("GET", "/api/v2/permissions"))
+	time.Sleep(20 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := ct.RoundTrip(newTestRequest("GET", "/api/v2/permissions").WithContext(ctx)); err != context.Canceled {
+		t.Errorf("expected the waiter to return its own cancellation, got %v", err)
+	}
+}
+
+func TestCacheTransportSingleFlightLeaderCanceled(t *testing.T) {
+	var calls int32
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBufferString(`{"data":[]}`))}, nil
+	})
+	ct := NewCacheTransport(rt, time.Minute)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderDone := make(chan error)
+	go func() {
+		_, err := ct.RoundTrip(newTestRequest("GET", "/api/v2/permissions").WithContext(ctx))
+		leaderDone <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+
+	waiterDone := make(chan struct{})
+	go func() {
+		defer close(waiterDone)
+		roundTripBody(t, ct, "GET", "/api/v2/permissions")
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderDone; err != context.Canceled {
+		t.Errorf("expected the leader to return its cancellation, got %v", err)
+	}
+	<-waiterDone
+	if calls != 2 {
+		t.Errorf("expected the waiter to send its own request, got %d calls", calls)
+	}
+}
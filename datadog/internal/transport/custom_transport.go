@@ -3,77 +3,102 @@ package transport
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io/ioutil"
+	"log"
 	"math"
+	"math/rand"
 	"net/http"
 	"strconv"
 	"time"
 )
 
 var (
-	maxRetries                       = 3
+	defaultMaxAttempts               = 4
 	defaultBackOffMultiplier float64 = 2
-	defaultBackOffBase       float64 = 2
+	defaultBackOffBase               = 2 * time.Second
 	defaultHTTPRetryTimeout          = 60 * time.Second
 	rateLimitResetHeader             = "X-Ratelimit-Reset"
+	idempotentHTTPMethods            = map[string]bool{
+		http.MethodGet:     true,
+		http.MethodHead:    true,
+		http.MethodOptions: true,
+		http.MethodPut:     true,
+		http.MethodDelete:  true,
+	}
 )
 
 // CustomTransport holds DefaultTransport configuration and is used to for custom http error handling
 type CustomTransport struct {
 	defaultTransport http.RoundTripper
 	httpRetryTimeout time.Duration
+	retryPolicy      RetryPolicy
+	rateLimiter      *rateLimiter
 }
 
 // CustomTransportOptions Set options for CustomTransport
 type CustomTransportOptions struct {
-	Timeout *time.Duration
+	Timeout     *time.Duration
+	RetryPolicy RetryPolicy
+	RateLimit   RateLimiterOptions
+}
+
+// RetryPolicy Set options for request retries. Zero values are replaced by the defaults.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts for a request, including the first one
+	MaxAttempts int
+	// BackOffBase is the wait time before the first retry, it is doubled on every retry
+	BackOffBase time.Duration
+	// BackOffMax caps the wait time between two attempts. Defaults to the retry timeout.
+	BackOffMax time.Duration
+	// Jitter picks a random wait time between 0 and the computed back off ("full jitter")
+	Jitter bool
+	// RetryableStatusCodes lists the HTTP status codes to retry. Defaults to 429 and 5xx.
+	RetryableStatusCodes []int
 }
 
 // RoundTrip method used to retry http errors
 func (t *CustomTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	var ccancel context.CancelFunc
-	ctx := req.Context()
-	if _, set := ctx.Deadline(); !set {
-		ctx, ccancel = context.WithTimeout(ctx, t.httpRetryTimeout)
-		defer ccancel()
-	}
+	// An earlier deadline set by the caller, e.g. a Terraform operation timeout, takes precedence
+	ctx, ccancel := context.WithTimeout(req.Context(), t.httpRetryTimeout)
+	defer ccancel()
 
 	var rawBody []byte
 	if req.Body != nil && req.Body != http.NoBody {
 		rawBody, _ = ioutil.ReadAll(req.Body)
 		req.Body.Close()
 	}
-	var resp *http.Response
-	var respErr error
-	retryCount := 0
-	for {
-		if retryCount == maxRetries {
-			ccancel()
-		}
-
+	for attempt := 1; ; attempt++ {
 		newRequest := t.copyRequest(req, &rawBody)
-		resp, respErr = t.defaultTransport.RoundTrip(newRequest)
+		done, err := t.rateLimiter.wait(newRequest.Context(), newRequest)
+		if err != nil {
+			return nil, err
+		}
+		resp, respErr := t.defaultTransport.RoundTrip(newRequest)
+		done(resp)
 		// Close the body so connection can be re-used
 		if resp != nil {
 			localVarBody, _ := ioutil.ReadAll(resp.Body)
 			resp.Body.Close()
 			resp.Body = ioutil.NopCloser(bytes.NewBuffer(localVarBody))
 		}
-		if respErr != nil {
-			return resp, respErr
-		}
 
 		// Check if request should be retried and get retry time
-		retryDuration, retry := t.retryRequest(resp, retryCount)
+		retryDuration, reason, retry := t.retryRequest(newRequest, resp, respErr, attempt)
 		if !retry {
 			return resp, respErr
 		}
+		if attempt >= t.retryPolicy.MaxAttempts {
+			log.Printf("[WARN] %s %s: giving up after %d attempts: %s", req.Method, req.URL.Path, attempt, reason)
+			return resp, respErr
+		}
+		log.Printf("[DEBUG] %s %s: attempt %d/%d failed (%s), retrying in %s", req.Method, req.URL.Path, attempt, t.retryPolicy.MaxAttempts, reason, retryDuration)
 
 		select {
 		case <-ctx.Done():
+			log.Printf("[WARN] %s %s: retry timeout reached after %d attempts: %s", req.Method, req.URL.Path, attempt, reason)
 			return resp, respErr
-		case <-time.After(*retryDuration):
-			retryCount++
+		case <-time.After(retryDuration):
 			continue
 		}
 	}
@@ -90,27 +115,55 @@ func (t *CustomTransport) copyRequest(r *http.Request, rawBody *[]byte) *http.Re
 	return &newRequest
 }
 
-func (t *CustomTransport) retryRequest(response *http.Response, retryCount int) (*time.Duration, bool) {
-	var err error
+// retryRequest returns whether the request should be retried, the time to wait before the next attempt and the reason of the retry
+func (t *CustomTransport) retryRequest(request *http.Request, response *http.Response, respErr error, attempt int) (time.Duration, string, bool) {
+	if respErr != nil {
+		// The caller gave up on the request, there is no point in retrying. An attempt that timed out
+		// on its own, see HTTPClientOptions.RequestTimeout, is retried like any connection error.
+		if request.Context().Err() != nil {
+			return 0, "", false
+		}
+		// Only retry connection errors when sending the request again can't have side effects
+		if !idempotentHTTPMethods[request.Method] {
+			return 0, "", false
+		}
+		return t.backOff(attempt), fmt.Sprintf("connection error: %v", respErr), true
+	}
+
+	if !t.isRetryableStatusCode(response.StatusCode) {
+		return 0, "", false
+	}
+
 	if v := response.Header.Get(rateLimitResetHeader); v != "" && response.StatusCode == 429 {
 		vInt, err := strconv.ParseInt(v, 10, 64)
 		if err == nil {
-			retryDuration := time.Duration(vInt) * time.Second
-			return &retryDuration, true
+			return time.Duration(vInt) * time.Second, "rate limited", true
 		}
 	}
 
-	// Calculate retry for 5xx errors or if unable to parse value of rateLimitResetHeader
-	if response.StatusCode >= 500 || err != nil {
-		// Calculate the retry val (base * multiplier^retryCount)
-		retryVal := defaultBackOffBase * math.Pow(defaultBackOffMultiplier, float64(retryCount))
-		// retry duration shouldn't exceed default timeout period
-		retryVal = math.Min(float64(t.httpRetryTimeout/time.Second), retryVal)
-		retryDuration := time.Duration(retryVal) * time.Second
-		return &retryDuration, true
+	return t.backOff(attempt), fmt.Sprintf("status code %d", response.StatusCode), true
+}
+
+func (t *CustomTransport) isRetryableStatusCode(statusCode int) bool {
+	if len(t.retryPolicy.RetryableStatusCodes) == 0 {
+		return statusCode == 429 || statusCode >= 500
+	}
+	for _, code := range t.retryPolicy.RetryableStatusCodes {
+		if code == statusCode {
+			return true
+		}
 	}
+	return false
+}
 
-	return nil, false
+// backOff computes the wait time before the next attempt: base * multiplier^(attempt-1), capped by BackOffMax
+func (t *CustomTransport) backOff(attempt int) time.Duration {
+	retryVal := float64(t.retryPolicy.BackOffBase) * math.Pow(defaultBackOffMultiplier, float64(attempt-1))
+	retryVal = math.Min(float64(t.retryPolicy.BackOffMax), retryVal)
+	if t.retryPolicy.Jitter {
+		retryVal = rand.Float64() * retryVal
+	}
+	return time.Duration(retryVal)
 }
 
 // NewCustomTransport returns new CustomTransport struct
@@ -122,6 +175,8 @@ func NewCustomTransport(t http.RoundTripper, opt CustomTransportOptions) *Custom
 
 	ct := CustomTransport{
 		defaultTransport: t,
+		retryPolicy:      opt.RetryPolicy,
+		rateLimiter:      newRateLimiter(opt.RateLimit),
 	}
 
 	if opt.Timeout != nil {
@@ -130,5 +185,46 @@ func NewCustomTransport(t http.RoundTripper, opt CustomTransportOptions) *Custom
 		ct.httpRetryTimeout = defaultHTTPRetryTimeout
 	}
 
+	if ct.retryPolicy.MaxAttempts <= 0 {
+		ct.retryPolicy.MaxAttempts = defaultMaxAttempts
+	}
+	if ct.retryPolicy.BackOffBase <= 0 {
+		ct.retryPolicy.BackOffBase = defaultBackOffBase
+	}
+	// retry duration shouldn't exceed default timeout period
+	if ct.retryPolicy.BackOffMax <= 0 || ct.retryPolicy.BackOffMax > ct.httpRetryTimeout {
+		ct.retryPolicy.BackOffMax = ct.httpRetryTimeout
+	}
+
 	return &ct
 }
+
+// RateLimitTransport only throttles requests, it is used when retries are disabled
+type RateLimitTransport struct {
+	defaultTransport http.RoundTripper
+	rateLimiter      *rateLimiter
+}
+
+// RoundTrip method used to throttle requests
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	done, err := t.rateLimiter.wait(req.Context(), req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.defaultTransport.RoundTrip(req)
+	done(resp)
+	return resp, err
+}
+
+// NewRateLimitTransport returns new RateLimitTransport struct
+func NewRateLimitTransport(t http.RoundTripper, opt RateLimiterOptions) *RateLimitTransport {
+	// Use default transport if one provided is nil
+	if t == nil {
+		t = http.DefaultTransport
+	}
+
+	return &RateLimitTransport{
+		defaultTransport: t,
+		rateLimiter:      newRateLimiter(opt),
+	}
+}
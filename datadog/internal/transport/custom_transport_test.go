@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func newTestTransport(responses []func(*http.Request) (*http.Response, error), policy RetryPolicy) (*CustomTransport, *int) {
+	calls := 0
+	rt := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		f := responses[calls]
+		calls++
+		return f(req)
+	})
+	if policy.BackOffBase == 0 {
+		policy.BackOffBase = time.Millisecond
+	}
+	return NewCustomTransport(rt, CustomTransportOptions{RetryPolicy: policy}), &calls
+}
+
+func statusResponse(code int) func(*http.Request) (*http.Response, error) {
+	return func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		return &http.Response{StatusCode: code, Header: http.Header{}, Body: ioutil.NopCloser(bytes.NewBuffer(body))}, nil
+	}
+}
+
+func connectionReset(*http.Request) (*http.Response, error) {
+	return nil, syscall.ECONNRESET
+}
+
+func attemptTimeout(*http.Request) (*http.Response, error) {
+	return nil, context.DeadlineExceeded
+}
+
+func TestCustomTransportRetries(t *testing.T) {
+	cases := map[string]struct {
+		method     string
+		policy     RetryPolicy
+		responses  []func(*http.Request) (*http.Response, error)
+		calls      int
+		statusCode int
+		err        error
+	}{
+		"success": {
+			"GET", RetryPolicy{}, []func(*http.Request) (*http.Response, error){statusResponse(200)}, 1, 200, nil,
+		},
+		"5xx is retried": {
+			"POST", RetryPolicy{}, []func(*http.Request) (*http.Response, error){statusResponse(502), statusResponse(200)}, 2, 200, nil,
+		},
+		"4xx is not retried": {
+			"GET", RetryPolicy{}, []func(*http.Request) (*http.Response, error){statusResponse(404)}, 1, 404, nil,
+		},
+		"max attempts": {
+			"GET", RetryPolicy{MaxAttempts: 2}, []func(*http.Request) (*http.Response, error){statusResponse(500), statusResponse(500)}, 2, 500, nil,
+		},
+		"custom retryable status codes": {
+			"GET", RetryPolicy{RetryableStatusCodes: []int{409}}, []func(*http.Request) (*http.Response, error){statusResponse(409), statusResponse(500)}, 2, 500, nil,
+		},
+		"connection error on GET is retried": {
+			"GET", RetryPolicy{}, []func(*http.Request) (*http.Response, error){connectionReset, statusResponse(200)}, 2, 200, nil,
+		},
+		"connection error on DELETE is retried": {
+			"DELETE", RetryPolicy{}, []func(*http.Request) (*http.Response, error){connectionReset, statusResponse(204)}, 2, 204, nil,
+		},
+		"attempt timeout on GET is retried": {
+			"GET", RetryPolicy{}, []func(*http.Request) (*http.Response, error){attemptTimeout, statusResponse(200)}, 2, 200, nil,
+		},
+		"connection error on POST is not retried": {
+			"POST", RetryPolicy{}, []func(*http.Request) (*http.Response, error){connectionReset}, 1, 0, syscall.ECONNRESET,
+		},
+	}
+	for name, tc := range cases {
+		ct, calls := newTestTransport(tc.responses, tc.policy)
+		req, _ := http.NewRequest(tc.method, "https://api.datadoghq.com/api/v1/monitor", strings.NewReader(`{"name":"foo"}`))
+
+		resp, err := ct.RoundTrip(req)
+		if *calls != tc.calls {
+			t.Errorf("%s: expected %d calls, got %d", name, tc.calls, *calls)
+		}
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected error '%v', got '%v'", name, tc.err, err)
+		}
+		if resp != nil && resp.StatusCode != tc.statusCode {
+			t.Errorf("%s: expected status code %d, got %d", name, tc.statusCode, resp.StatusCode)
+		}
+		if resp != nil {
+			// The request body must be replayed on every attempt
+			body, _ := ioutil.ReadAll(resp.Body)
+			if string(body) != `{"name":"foo"}` {
+				t.Errorf("%s: request body was not replayed, got '%s'", name, body)
+			}
+		}
+	}
+}
+
+func TestCustomTransportBackOff(t *testing.T) {
+	timeout := 10 * time.Second
+	ct := NewCustomTransport(nil, CustomTransportOptions{Timeout: &timeout, RetryPolicy: RetryPolicy{BackOffBase: time.Second, BackOffMax: 5 * time.Second}})
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second} {
+		if backOff := ct.backOff(attempt); backOff != expected {
+			t.Errorf("attempt %d: expected back off of %s, got %s", attempt, expected, backOff)
+		}
+	}
+
+	ct.retryPolicy.Jitter = true
+	for i := 0; i < 100; i++ {
+		if backOff := ct.backOff(4); backOff < 0 || backOff > 5*time.Second {
+			t.Fatalf("jittered back off should be between 0 and 5s, got %s", backOff)
+		}
+	}
+}
+
+func TestCustomTransportCancelledRequest(t *testing.T) {
+	ct, calls := newTestTransport([]func(*http.Request) (*http.Response, error){attemptTimeout, statusResponse(200)}, RetryPolicy{})
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, "GET", "https://api.datadoghq.com/api/v1/monitor", nil)
+
+	if _, err := ct.RoundTrip(req); err == nil || *calls != 1 {
+		t.Errorf("expected a cancelled request not to be retried, got %d calls and error '%v'", *calls, err)
+	}
+}
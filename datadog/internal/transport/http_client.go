@@ -0,0 +1,138 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// HTTPClientOptions Set networking options for the HTTP clients
+type HTTPClientOptions struct {
+	// ProxyURL is the proxy used for HTTP and HTTPS requests. Defaults to the HTTP_PROXY and HTTPS_PROXY environment variables.
+	ProxyURL string
+	// NoProxy lists the hosts that must not go through the proxy. Defaults to the NO_PROXY environment variable.
+	NoProxy []string
+	// CACertFiles lists PEM bundles of certificate authorities trusted in addition to the system ones
+	CACertFiles []string
+	// ClientCertFile and ClientKeyFile are the PEM certificate and key used for mutual TLS
+	ClientCertFile string
+	ClientKeyFile  string
+	// RequestTimeout caps the duration of each request attempt, including reading the response body. 0 disables it.
+	RequestTimeout time.Duration
+	// IdleConnTimeout is how long an idle connection is kept open. Defaults to 90 seconds.
+	IdleConnTimeout time.Duration
+}
+
+// NewHTTPTransport returns the base transport shared by the HTTP clients of a provider instance
+func NewHTTPTransport(opt HTTPClientOptions) (http.RoundTripper, error) {
+	t := cleanhttp.DefaultPooledTransport()
+
+	if opt.ProxyURL != "" || len(opt.NoProxy) > 0 {
+		proxyConfig := httpproxy.FromEnvironment()
+		if opt.ProxyURL != "" {
+			if u, err := url.Parse(opt.ProxyURL); err != nil || u.Host == "" {
+				return nil, fmt.Errorf("invalid proxy URL %q, expected e.g. `http://proxy.example.com:3128`", opt.ProxyURL)
+			}
+			proxyConfig.HTTPProxy = opt.ProxyURL
+			proxyConfig.HTTPSProxy = opt.ProxyURL
+		}
+		if len(opt.NoProxy) > 0 {
+			proxyConfig.NoProxy = strings.Join(opt.NoProxy, ",")
+		}
+		proxyFunc := proxyConfig.ProxyFunc()
+		t.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+	}
+
+	if len(opt.CACertFiles) > 0 || opt.ClientCertFile != "" || opt.ClientKeyFile != "" {
+		tlsConfig, err := buildTLSConfig(opt)
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig = tlsConfig
+	}
+
+	if opt.IdleConnTimeout > 0 {
+		t.IdleConnTimeout = opt.IdleConnTimeout
+	}
+
+	if opt.RequestTimeout > 0 {
+		return &requestTimeoutTransport{defaultTransport: t, timeout: opt.RequestTimeout}, nil
+	}
+	return t, nil
+}
+
+func buildTLSConfig(opt HTTPClientOptions) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if len(opt.CACertFiles) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		for _, file := range opt.CACertFiles {
+			pem, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("error reading CA bundle: %w", err)
+			}
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no PEM certificate found in CA bundle %s", file)
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if opt.ClientCertFile != "" || opt.ClientKeyFile != "" {
+		if opt.ClientCertFile == "" || opt.ClientKeyFile == "" {
+			return nil, fmt.Errorf("both a client certificate and a client key are required for mutual TLS")
+		}
+		cert, err := tls.LoadX509KeyPair(opt.ClientCertFile, opt.ClientKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	return tlsConfig, nil
+}
+
+// requestTimeoutTransport cancels a request attempt that takes longer than the timeout. Unlike
+// http.Client.Timeout, each retry of the CustomTransport gets the full timeout.
+type requestTimeoutTransport struct {
+	defaultTransport http.RoundTripper
+	timeout          time.Duration
+}
+
+// RoundTrip method used to apply the request timeout
+func (t *requestTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.defaultTransport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return resp, err
+	}
+	// The timeout also covers reading the body, release the context once it is closed
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
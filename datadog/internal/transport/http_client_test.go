@@ -0,0 +1,96 @@
+package transport
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestHTTPTransportProxy(t *testing.T) {
+	rt, err := NewHTTPTransport(HTTPClientOptions{ProxyURL: "http://proxy.example.com:3128", NoProxy: []string{"internal.example.com"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	proxy := rt.(*http.Transport).Proxy
+
+	cases := map[string]struct {
+		url   string
+		proxy string
+	}{
+		"proxied":     {"https://api.datadoghq.com/api/v1/validate", "http://proxy.example.com:3128"},
+		"not proxied": {"https://internal.example.com/api/v1/validate", ""},
+	}
+	for name, tc := range cases {
+		u, _ := url.Parse(tc.url)
+		proxyURL, err := proxy(&http.Request{URL: u})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if (proxyURL == nil && tc.proxy != "") || (proxyURL != nil && proxyURL.String() != tc.proxy) {
+			t.Errorf("%s: expected proxy '%s', got '%v'", name, tc.proxy, proxyURL)
+		}
+	}
+
+	if _, err := NewHTTPTransport(HTTPClientOptions{ProxyURL: "proxy"}); err == nil {
+		t.Errorf("expected an error for an invalid proxy URL")
+	}
+}
+
+func TestHTTPTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	rt, err := NewHTTPTransport(HTTPClientOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: rt}).Get(server.URL); err == nil {
+		t.Errorf("expected the self-signed certificate to be rejected without CA bundle")
+	}
+
+	rt, err = NewHTTPTransport(HTTPClientOptions{CACertFiles: []string{caFile}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := (&http.Client{Transport: rt}).Get(server.URL); err != nil {
+		t.Errorf("expected the certificate to be trusted with the CA bundle, got %v", err)
+	}
+
+	if _, err := NewHTTPTransport(HTTPClientOptions{ClientCertFile: caFile}); err == nil {
+		t.Errorf("expected an error for a client certificate without key")
+	}
+}
+
+func TestHTTPTransportRequestTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}))
+	defer server.Close()
+
+	rt, err := NewHTTPTransport(HTTPClientOptions{RequestTimeout: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := &http.Client{Transport: rt}
+	if _, err := client.Get(server.URL + "/slow"); err == nil {
+		t.Errorf("expected slow request to time out")
+	}
+	resp, err := client.Get(server.URL + "/fast")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+}
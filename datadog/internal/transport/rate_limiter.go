@@ -0,0 +1,200 @@
+package transport
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	rateLimitLimitHeader     = "X-Ratelimit-Limit"
+	rateLimitPeriodHeader    = "X-Ratelimit-Period"
+	rateLimitRemainingHeader = "X-Ratelimit-Remaining"
+	rateLimitNameHeader      = "X-Ratelimit-Name"
+)
+
+// RateLimiterOptions Set options for the client side rate limiter
+type RateLimiterOptions struct {
+	// MaxRequestsPerSecond caps the rate of requests sent by the provider instance. 0 disables the cap.
+	MaxRequestsPerSecond float64
+	// MaxConcurrentRequests caps the number of in-flight requests. 0 disables the cap.
+	MaxConcurrentRequests int
+}
+
+// rateLimitBucket holds the last known state of a Datadog rate limit, as reported by the X-RateLimit-* headers
+type rateLimitBucket struct {
+	limit     int
+	remaining int
+	period    time.Duration
+	resetAt   time.Time
+	inFlight  int
+}
+
+// rateLimiter throttles requests before they get rejected by the API. It is shared by every resource
+// of a provider instance since they all use the same http.Client.
+type rateLimiter struct {
+	mu sync.Mutex
+
+	// Global throttling configured through the provider
+	interval    time.Duration
+	nextAllowed time.Time
+	slots       chan struct{}
+
+	// Datadog rate limits, keyed by X-RateLimit-Name. endpoints maps a request endpoint
+	// to the bucket name it was last seen with.
+	buckets   map[string]*rateLimitBucket
+	endpoints map[string]string
+
+	now func() time.Time
+}
+
+func newRateLimiter(opt RateLimiterOptions) *rateLimiter {
+	rl := rateLimiter{
+		buckets:   make(map[string]*rateLimitBucket),
+		endpoints: make(map[string]string),
+		now:       time.Now,
+	}
+	if opt.MaxRequestsPerSecond > 0 {
+		rl.interval = time.Duration(float64(time.Second) / opt.MaxRequestsPerSecond)
+	}
+	if opt.MaxConcurrentRequests > 0 {
+		rl.slots = make(chan struct{}, opt.MaxConcurrentRequests)
+	}
+	return &rl
+}
+
+// wait blocks until the request is allowed to be sent. The returned func must be called once the
+// request completed, with the response if any.
+func (rl *rateLimiter) wait(ctx context.Context, req *http.Request) (func(*http.Response), error) {
+	if rl.slots != nil {
+		select {
+		case rl.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if rl.slots != nil {
+			<-rl.slots
+		}
+	}
+
+	endpoint := rateLimitEndpoint(req)
+	for {
+		delay, bucket := rl.reserve(endpoint)
+		if delay <= 0 {
+			return func(resp *http.Response) {
+				rl.update(endpoint, bucket, resp)
+				release()
+			}, nil
+		}
+
+		log.Printf("[DEBUG] Throttling request to %s for %s", endpoint, delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			release()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// reserve returns how long to wait before sending a request to the endpoint. When no wait is
+// needed, the request is accounted for and the matching bucket, if known, is returned.
+func (rl *rateLimiter) reserve(endpoint string) (time.Duration, *rateLimitBucket) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	var bucket *rateLimitBucket
+	if name, ok := rl.endpoints[endpoint]; ok {
+		bucket = rl.buckets[name]
+	}
+	if bucket != nil {
+		if !now.Before(bucket.resetAt) {
+			// The period rolled over, the API will send us a fresh state with the next response
+			bucket.remaining = bucket.limit
+			bucket.resetAt = now.Add(bucket.period)
+		}
+		if bucket.remaining-bucket.inFlight <= 0 {
+			return bucket.resetAt.Sub(now), nil
+		}
+	}
+
+	if rl.interval > 0 {
+		if now.Before(rl.nextAllowed) {
+			return rl.nextAllowed.Sub(now), nil
+		}
+		rl.nextAllowed = now.Add(rl.interval)
+	}
+
+	if bucket != nil {
+		bucket.inFlight++
+	}
+	return 0, bucket
+}
+
+// update records the rate limit state returned by the API
+func (rl *rateLimiter) update(endpoint string, reserved *rateLimitBucket, resp *http.Response) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	if reserved != nil {
+		reserved.inFlight--
+	}
+	if resp == nil {
+		return
+	}
+
+	name := resp.Header.Get(rateLimitNameHeader)
+	if name == "" {
+		return
+	}
+	limit, err := strconv.Atoi(resp.Header.Get(rateLimitLimitHeader))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(resp.Header.Get(rateLimitRemainingHeader))
+	if err != nil {
+		return
+	}
+	period, err := strconv.Atoi(resp.Header.Get(rateLimitPeriodHeader))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.Atoi(resp.Header.Get(rateLimitResetHeader))
+	if err != nil {
+		reset = period
+	}
+
+	bucket, ok := rl.buckets[name]
+	if !ok {
+		bucket = &rateLimitBucket{}
+		rl.buckets[name] = bucket
+	}
+	bucket.limit = limit
+	bucket.remaining = remaining
+	bucket.period = time.Duration(period) * time.Second
+	bucket.resetAt = rl.now().Add(time.Duration(reset) * time.Second)
+	rl.endpoints[endpoint] = name
+}
+
+// rateLimitEndpoint returns the key used to match a request with a rate limit bucket before
+// the API told us which bucket it belongs to, e.g. `GET /api/v1/monitor`.
+func rateLimitEndpoint(req *http.Request) string {
+	return req.Method + " " + endpointFamily(req)
+}
+
+// endpointFamily returns the host and first three path segments of a request, e.g.
+// `api.datadoghq.com/api/v2/roles` for both `/api/v2/roles` and `/api/v2/roles/abc/permissions`
+func endpointFamily(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(segments) > 3 {
+		segments = segments[:3]
+	}
+	return req.URL.Host + "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,118 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func newTestRequest(method, path string) *http.Request {
+	return &http.Request{Method: method, URL: &url.URL{Scheme: "https", Host: "api.datadoghq.com", Path: path}}
+}
+
+func rateLimitResponse(name string, limit, remaining, period, reset int) *http.Response {
+	header := http.Header{}
+	header.Set(rateLimitNameHeader, name)
+	header.Set(rateLimitLimitHeader, strconv.Itoa(limit))
+	header.Set(rateLimitRemainingHeader, strconv.Itoa(remaining))
+	header.Set(rateLimitPeriodHeader, strconv.Itoa(period))
+	header.Set(rateLimitResetHeader, strconv.Itoa(reset))
+	return &http.Response{StatusCode: 200, Header: header}
+}
+
+func TestRateLimitEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		method   string
+		path     string
+		endpoint string
+	}{
+		"collection": {"GET", "/api/v1/monitor", "GET api.datadoghq.com/api/v1/monitor"},
+		"item":       {"GET", "/api/v1/monitor/1234", "GET api.datadoghq.com/api/v1/monitor"},
+		"sub item":   {"PUT", "/api/v2/roles/abc/permissions", "PUT api.datadoghq.com/api/v2/roles"},
+	}
+	for name, tc := range cases {
+		if endpoint := rateLimitEndpoint(newTestRequest(tc.method, tc.path)); endpoint != tc.endpoint {
+			t.Errorf("%s: expected endpoint '%s', got '%s'", name, tc.endpoint, endpoint)
+		}
+	}
+}
+
+func TestRateLimiterExhaustedBucket(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter(RateLimiterOptions{})
+	rl.now = func() time.Time { return now }
+
+	req := newTestRequest("GET", "/api/v1/monitor/1")
+	done, err := rl.wait(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	done(rateLimitResponse("monitors", 5, 0, 10, 3))
+
+	if delay, _ := rl.reserve(rateLimitEndpoint(req)); delay != 3*time.Second {
+		t.Errorf("expected request to be delayed by 3s, got %s", delay)
+	}
+
+	now = now.Add(3 * time.Second)
+	if delay, bucket := rl.reserve(rateLimitEndpoint(req)); delay != 0 || bucket == nil {
+		t.Errorf("expected request to be allowed once the bucket is reset, got %s", delay)
+	} else if bucket.remaining != 5 || bucket.inFlight != 1 {
+		t.Errorf("expected bucket to be refilled, got %+v", bucket)
+	}
+}
+
+func TestRateLimiterInFlightRequests(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter(RateLimiterOptions{})
+	rl.now = func() time.Time { return now }
+
+	endpoint := rateLimitEndpoint(newTestRequest("GET", "/api/v1/monitor"))
+	rl.update(endpoint, nil, rateLimitResponse("monitors", 5, 2, 10, 5))
+
+	for i := 0; i < 2; i++ {
+		if delay, _ := rl.reserve(endpoint); delay != 0 {
+			t.Fatalf("request %d should not be delayed, got %s", i, delay)
+		}
+	}
+	if delay, _ := rl.reserve(endpoint); delay != 5*time.Second {
+		t.Errorf("expected third in-flight request to wait for the reset, got %s", delay)
+	}
+}
+
+func TestRateLimiterMaxRequestsPerSecond(t *testing.T) {
+	now := time.Unix(0, 0)
+	rl := newRateLimiter(RateLimiterOptions{MaxRequestsPerSecond: 4})
+	rl.now = func() time.Time { return now }
+
+	endpoint := rateLimitEndpoint(newTestRequest("GET", "/api/v1/dashboard"))
+	if delay, _ := rl.reserve(endpoint); delay != 0 {
+		t.Fatalf("first request should not be delayed, got %s", delay)
+	}
+	if delay, _ := rl.reserve(endpoint); delay != 250*time.Millisecond {
+		t.Errorf("expected second request to be delayed by 250ms, got %s", delay)
+	}
+}
+
+func TestRateLimiterMaxConcurrentRequests(t *testing.T) {
+	rl := newRateLimiter(RateLimiterOptions{MaxConcurrentRequests: 1})
+	req := newTestRequest("GET", "/api/v1/dashboard")
+
+	done, err := rl.wait(context.Background(), req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := rl.wait(ctx, req); err != context.DeadlineExceeded {
+		t.Errorf("expected second request to wait for a free slot, got %v", err)
+	}
+
+	done(nil)
+	if _, err := rl.wait(context.Background(), req); err != nil {
+		t.Errorf("expected slot to be released, got %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Credentials holds the API and APP keys loaded from an external source
+type Credentials struct {
+	APIKey string `json:"api_key" yaml:"api_key"`
+	APPKey string `json:"app_key" yaml:"app_key"`
+}
+
+// LoadCredentialsFile reads the credentials of a profile from a credentials file. Files with a
+// `.yaml` or `.yml` extension are parsed as YAML, any other file is parsed as INI:
+//
+//	[default]
+//	api_key = <DATADOG_API_KEY>
+//	app_key = <DATADOG_APP_KEY>
+func LoadCredentialsFile(path string, profile string) (*Credentials, error) {
+	path, err := expandHomeDir(path)
+	if err != nil {
+		return nil, err
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading credentials file: %w", err)
+	}
+
+	var profiles map[string]Credentials
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		if err := yaml.Unmarshal(content, &profiles); err != nil {
+			return nil, fmt.Errorf("error parsing credentials file %s: %w", path, err)
+		}
+	default:
+		if profiles, err = parseINICredentials(content); err != nil {
+			return nil, fmt.Errorf("error parsing credentials file %s: %w", path, err)
+		}
+	}
+
+	credentials, ok := profiles[profile]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in credentials file %s", profile, path)
+	}
+	return &credentials, nil
+}
+
+func parseINICredentials(content []byte) (map[string]Credentials, error) {
+	profiles := make(map[string]Credentials)
+	profile := ""
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			profile = strings.TrimSpace(line[1 : len(line)-1])
+			profiles[profile] = Credentials{}
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected `key = value`", lineNumber)
+		}
+		if profile == "" {
+			return nil, fmt.Errorf("line %d: key outside of a [profile] section", lineNumber)
+		}
+		credentials := profiles[profile]
+		switch strings.TrimSpace(key) {
+		case "api_key":
+			credentials.APIKey = strings.TrimSpace(value)
+		case "app_key":
+			credentials.APPKey = strings.TrimSpace(value)
+		}
+		profiles[profile] = credentials
+	}
+	return profiles, scanner.Err()
+}
+
+// RunCredentialProcess runs a command through the shell and parses the credentials it prints
+// on its standard output as JSON, e.g. `{"api_key": "...", "app_key": "..."}`
+func RunCredentialProcess(ctx context.Context, command string) (*Credentials, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", command)
+	} else {
+		cmd = exec.CommandContext(ctx, "/bin/sh", "-c", command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("error running credential_process: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var credentials Credentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		// The output is not included in the error as it may hold secrets
+		return nil, fmt.Errorf("error parsing credential_process output, expected a JSON object with `api_key` and `app_key`: %w", err)
+	}
+	return &credentials, nil
+}
+
+func expandHomeDir(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("error expanding %s: %w", path, err)
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestLoadCredentialsFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"credentials": `
+# Datadog credentials
+[default]
+api_key = api-default
+app_key = app-default
+
+[staging]
+api_key=api-staging
+`,
+		"credentials.yaml": `
+default:
+  api_key: api-default
+  app_key: app-default
+staging:
+  api_key: api-staging
+`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cases := map[string]struct {
+		file     string
+		profile  string
+		expected Credentials
+		err      bool
+	}{
+		"ini default":  {"credentials", "default", Credentials{"api-default", "app-default"}, false},
+		"ini profile":  {"credentials", "staging", Credentials{"api-staging", ""}, false},
+		"ini missing":  {"credentials", "prod", Credentials{}, true},
+		"yaml default": {"credentials.yaml", "default", Credentials{"api-default", "app-default"}, false},
+		"yaml profile": {"credentials.yaml", "staging", Credentials{"api-staging", ""}, false},
+		"yaml missing": {"credentials.yaml", "prod", Credentials{}, true},
+		"missing file": {"missing", "default", Credentials{}, true},
+	}
+	for name, tc := range cases {
+		credentials, err := LoadCredentialsFile(filepath.Join(dir, tc.file), tc.profile)
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		} else if *credentials != tc.expected {
+			t.Errorf("%s: expected %+v, got %+v", name, tc.expected, *credentials)
+		}
+	}
+}
+
+func TestParseINICredentialsErrors(t *testing.T) {
+	for name, content := range map[string]string{
+		"no section":   "api_key = foo",
+		"invalid line": "[default]\napi_key",
+	} {
+		if _, err := parseINICredentials([]byte(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestRunCredentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential_process tests rely on a POSIX shell")
+	}
+
+	credentials, err := RunCredentialProcess(context.Background(), `echo '{"api_key": "api", "app_key": "app"}'`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *credentials != (Credentials{"api", "app"}) {
+		t.Errorf("unexpected credentials %+v", *credentials)
+	}
+
+	if _, err := RunCredentialProcess(context.Background(), "echo not json"); err == nil {
+		t.Errorf("expected an error on invalid output")
+	}
+	if _, err := RunCredentialProcess(context.Background(), "exit 1"); err == nil {
+		t.Errorf("expected an error on failing command")
+	}
+}
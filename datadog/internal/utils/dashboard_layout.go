@@ -0,0 +1,113 @@
+package utils
+
+import "fmt"
+
+// WidgetLayout is the position and size of a widget on a dashboard grid
+type WidgetLayout struct {
+	X      int64
+	Y      int64
+	Width  int64
+	Height int64
+}
+
+// Overlaps returns whether two layouts share at least one cell
+func (l WidgetLayout) Overlaps(o WidgetLayout) bool {
+	return l.X < o.X+o.Width && o.X < l.X+l.Width && l.Y < o.Y+o.Height && o.Y < l.Y+l.Height
+}
+
+func (l WidgetLayout) String() string {
+	return fmt.Sprintf("%dx%d at (%d, %d)", l.Width, l.Height, l.X, l.Y)
+}
+
+// WidgetSize is the size of a widget on a dashboard grid
+type WidgetSize struct {
+	Width  int64
+	Height int64
+}
+
+// WidgetGrid is the grid the widgets of a dashboard, or of a group, are placed on
+type WidgetGrid struct {
+	// Columns is the number of columns of the grid, 0 when it's unbounded
+	Columns int64
+	// WidgetSize is the default size of the widgets placed without layout
+	WidgetSize WidgetSize
+}
+
+// ReflowColumns is the number of columns of ordered dashboards and of groups
+const ReflowColumns = 12
+
+var (
+	// ReflowWidgetGrid is the grid of ordered dashboards, and of groups
+	ReflowWidgetGrid = WidgetGrid{Columns: ReflowColumns, WidgetSize: WidgetSize{Width: 4, Height: 2}}
+	// FreeWidgetGrid is the grid of free dashboards
+	FreeWidgetGrid = WidgetGrid{Columns: 0, WidgetSize: WidgetSize{Width: 32, Height: 16}}
+)
+
+// PlacedWidgetLayout is the layout of a widget at a Terraform path, e.g. `widget.0`
+type PlacedWidgetLayout struct {
+	Path   string
+	Layout WidgetLayout
+}
+
+// ValidateWidgetLayouts checks that the layouts of sibling widgets fit in the columns of grid and don't overlap
+func ValidateWidgetLayouts(layouts []PlacedWidgetLayout, grid WidgetGrid) []error {
+	var errs []error
+	for i, l := range layouts {
+		if grid.Columns > 0 && l.Layout.X+l.Layout.Width > grid.Columns {
+			errs = append(errs, fmt.Errorf("%s: widget layout %s exceeds the %d columns of the grid", l.Path, l.Layout, grid.Columns))
+		}
+		for _, o := range layouts[:i] {
+			if l.Layout.Overlaps(o.Layout) {
+				errs = append(errs, fmt.Errorf("%s: widget layout %s overlaps the layout %s of %s", l.Path, l.Layout, o.Layout, o.Path))
+			}
+		}
+	}
+	return errs
+}
+
+// PackWidgetLayouts places widgets of the given sizes around the placed layouts, each one in the first space it fits
+// in, from top to bottom and left to right. Unbounded grids are packed as wide as 4 default widgets, or as their
+// widest placed widget. The layouts are returned in the order of sizes, and only depend on the arguments.
+func PackWidgetLayouts(placed []WidgetLayout, sizes []WidgetSize, grid WidgetGrid) []WidgetLayout {
+	columns := grid.Columns
+	if columns == 0 {
+		columns = 4 * grid.WidgetSize.Width
+		for _, l := range placed {
+			if l.X+l.Width > columns {
+				columns = l.X + l.Width
+			}
+		}
+	}
+
+	occupied := append([]WidgetLayout(nil), placed...)
+	layouts := make([]WidgetLayout, 0, len(sizes))
+	for _, size := range sizes {
+		if size.Width > columns {
+			size.Width = columns
+		}
+		layout := firstFreeLayout(occupied, size, columns)
+		occupied = append(occupied, layout)
+		layouts = append(layouts, layout)
+	}
+	return layouts
+}
+
+func firstFreeLayout(occupied []WidgetLayout, size WidgetSize, columns int64) WidgetLayout {
+	for y := int64(0); ; y++ {
+		for x := int64(0); x+size.Width <= columns; x++ {
+			candidate := WidgetLayout{X: x, Y: y, Width: size.Width, Height: size.Height}
+			free := true
+			for _, l := range occupied {
+				if candidate.Overlaps(l) {
+					free = false
+					// Skip the columns of the overlapped widget
+					x = l.X + l.Width - 1
+					break
+				}
+			}
+			if free {
+				return candidate
+			}
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateWidgetLayouts(t *testing.T) {
+	cases := map[string]struct {
+		layouts []PlacedWidgetLayout
+		grid    WidgetGrid
+		errs    []string
+	}{
+		"side by side": {
+			layouts: []PlacedWidgetLayout{
+				{"widget.0", WidgetLayout{0, 0, 6, 2}},
+				{"widget.1", WidgetLayout{6, 0, 6, 2}},
+				{"widget.2", WidgetLayout{0, 2, 12, 3}},
+			},
+			grid: ReflowWidgetGrid,
+		},
+		"overlap": {
+			layouts: []PlacedWidgetLayout{
+				{"widget.0", WidgetLayout{0, 0, 6, 2}},
+				{"widget.1", WidgetLayout{5, 1, 4, 2}},
+			},
+			grid: ReflowWidgetGrid,
+			errs: []string{"widget.1: widget layout 4x2 at (5, 1) overlaps the layout 6x2 at (0, 0) of widget.0"},
+		},
+		"out of bounds": {
+			layouts: []PlacedWidgetLayout{
+				{"widget.0", WidgetLayout{8, 0, 6, 2}},
+			},
+			grid: ReflowWidgetGrid,
+			errs: []string{"widget.0: widget layout 6x2 at (8, 0) exceeds the 12 columns of the grid"},
+		},
+		"unbounded": {
+			layouts: []PlacedWidgetLayout{
+				{"widget.0", WidgetLayout{101, 0, 39, 46}},
+				{"widget.1", WidgetLayout{0, 0, 32, 43}},
+			},
+			grid: FreeWidgetGrid,
+		},
+	}
+	for name, tc := range cases {
+		var errs []string
+		for _, err := range ValidateWidgetLayouts(tc.layouts, tc.grid) {
+			errs = append(errs, err.Error())
+		}
+		if !reflect.DeepEqual(errs, tc.errs) {
+			t.Errorf("%s: expected errors %v, got %v", name, tc.errs, errs)
+		}
+	}
+}
+
+func TestPackWidgetLayouts(t *testing.T) {
+	cases := map[string]struct {
+		placed  []WidgetLayout
+		sizes   []WidgetSize
+		grid    WidgetGrid
+		layouts []WidgetLayout
+	}{
+		"empty reflow grid": {
+			sizes:   []WidgetSize{{4, 2}, {4, 2}, {4, 2}, {4, 2}},
+			grid:    ReflowWidgetGrid,
+			layouts: []WidgetLayout{{0, 0, 4, 2}, {4, 0, 4, 2}, {8, 0, 4, 2}, {0, 2, 4, 2}},
+		},
+		"around placed widgets": {
+			placed:  []WidgetLayout{{0, 0, 6, 3}, {8, 0, 4, 1}},
+			sizes:   []WidgetSize{{4, 2}, {4, 2}, {4, 2}},
+			grid:    ReflowWidgetGrid,
+			layouts: []WidgetLayout{{6, 1, 4, 2}, {0, 3, 4, 2}, {4, 3, 4, 2}},
+		},
+		"free grid": {
+			sizes:   []WidgetSize{{32, 16}, {32, 16}, {32, 16}, {32, 16}, {32, 16}},
+			grid:    FreeWidgetGrid,
+			layouts: []WidgetLayout{{0, 0, 32, 16}, {32, 0, 32, 16}, {64, 0, 32, 16}, {96, 0, 32, 16}, {0, 16, 32, 16}},
+		},
+		"free grid widened by placed widgets": {
+			placed:  []WidgetLayout{{0, 0, 160, 10}},
+			sizes:   []WidgetSize{{32, 16}, {32, 16}},
+			grid:    FreeWidgetGrid,
+			layouts: []WidgetLayout{{0, 10, 32, 16}, {32, 10, 32, 16}},
+		},
+		"mixed sizes": {
+			sizes:   []WidgetSize{{4, 2}, {12, 5}, {6, 2}, {6, 2}},
+			grid:    ReflowWidgetGrid,
+			layouts: []WidgetLayout{{0, 0, 4, 2}, {0, 2, 12, 5}, {4, 0, 6, 2}, {0, 7, 6, 2}},
+		},
+		"widgets wider than the grid": {
+			sizes:   []WidgetSize{{4, 2}, {4, 2}},
+			grid:    WidgetGrid{Columns: 3, WidgetSize: WidgetSize{4, 2}},
+			layouts: []WidgetLayout{{0, 0, 3, 2}, {0, 2, 3, 2}},
+		},
+	}
+	for name, tc := range cases {
+		layouts := PackWidgetLayouts(tc.placed, tc.sizes, tc.grid)
+		if !reflect.DeepEqual(layouts, tc.layouts) {
+			t.Errorf("%s: expected layouts %v, got %v", name, tc.layouts, layouts)
+		}
+		var placed []PlacedWidgetLayout
+		for _, l := range append(append([]WidgetLayout(nil), tc.placed...), layouts...) {
+			placed = append(placed, PlacedWidgetLayout{Layout: l})
+		}
+		if errs := ValidateWidgetLayouts(placed, tc.grid); len(errs) > 0 {
+			t.Errorf("%s: packed layouts are invalid: %v", name, errs)
+		}
+	}
+}
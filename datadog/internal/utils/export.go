@@ -0,0 +1,197 @@
+package utils
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var exportNameInvalidCharsRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+// ExportResourceName builds a unique Terraform resource name from the name of an object, e.g.
+// `cpu_is_high` for `CPU is high`. It falls back to the ID when the name has no usable characters.
+func ExportResourceName(name string, id string, used map[string]bool) string {
+	base := strings.Trim(exportNameInvalidCharsRegex.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if base == "" {
+		base = strings.Trim(exportNameInvalidCharsRegex.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if base == "" || (base[0] >= '0' && base[0] <= '9') {
+		base = "_" + base
+	}
+	resourceName := base
+	for i := 2; used[resourceName]; i++ {
+		resourceName = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[resourceName] = true
+	return resourceName
+}
+
+// ExportTraversal returns the traversal of a resource attribute address, e.g. `datadog_monitor.foo.id`
+func ExportTraversal(address string) hcl.Traversal {
+	parts := strings.Split(address, ".")
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: parts[0]}}
+	for _, part := range parts[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: part})
+	}
+	return traversal
+}
+
+// WriteResourceBody writes the configurable attributes of a resource as HCL, skipping read-only and
+// deprecated attributes as well as the ones left to their default. The values of the attributes listed in
+// `references` are replaced with the matching reference, e.g. `datadog_monitor.foo.id`.
+func WriteResourceBody(body *hclwrite.Body, s map[string]*schema.Schema, values map[string]interface{}, references map[string]map[string]string) {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var blockKeys []string
+	for _, k := range keys {
+		attr := s[k]
+		v, ok := values[k]
+		if !ok || !isExportedValue(attr, v) {
+			continue
+		}
+		if _, ok := attr.Elem.(*schema.Resource); ok {
+			blockKeys = append(blockKeys, k)
+			continue
+		}
+		writeAttribute(body, k, attr, v, references[k])
+	}
+
+	for _, k := range blockKeys {
+		elem := s[k].Elem.(*schema.Resource)
+		for _, item := range exportListItems(values[k]) {
+			itemValues, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			block := hclwrite.NewBlock(k, nil)
+			WriteResourceBody(block.Body(), elem.Schema, itemValues, nil)
+			if len(block.Body().Attributes()) == 0 && len(block.Body().Blocks()) == 0 {
+				continue
+			}
+			// Separate the blocks from the attributes
+			if len(body.Attributes()) > 0 && len(body.Blocks()) == 0 {
+				body.AppendNewline()
+			}
+			body.AppendBlock(block)
+		}
+	}
+}
+
+func isExportedValue(s *schema.Schema, v interface{}) bool {
+	if s.Required {
+		return true
+	}
+	if !s.Optional || s.Deprecated != "" {
+		return false
+	}
+	if s.Default != nil {
+		return !reflect.DeepEqual(s.Default, v)
+	}
+	switch value := v.(type) {
+	case nil:
+		return false
+	case *schema.Set:
+		return value.Len() > 0
+	case []interface{}:
+		return len(value) > 0
+	case map[string]interface{}:
+		return len(value) > 0
+	default:
+		return !reflect.ValueOf(v).IsZero()
+	}
+}
+
+func exportListItems(v interface{}) []interface{} {
+	switch value := v.(type) {
+	case *schema.Set:
+		return value.List()
+	case []interface{}:
+		return value
+	}
+	return nil
+}
+
+func writeAttribute(body *hclwrite.Body, k string, s *schema.Schema, v interface{}, references map[string]string) {
+	switch s.Type {
+	case schema.TypeList, schema.TypeSet:
+		items := exportListItems(v)
+		elems := make([]hclwrite.Tokens, 0, len(items))
+		for _, item := range items {
+			if address, ok := references[fmt.Sprint(item)]; ok {
+				elems = append(elems, hclwrite.TokensForTraversal(ExportTraversal(address)))
+			} else {
+				elems = append(elems, exportValueTokens(exportPrimitiveValue(item)))
+			}
+		}
+		body.SetAttributeRaw(k, hclwrite.TokensForTuple(elems))
+	case schema.TypeMap:
+		elems := make(map[string]cty.Value)
+		for mapKey, item := range v.(map[string]interface{}) {
+			elems[mapKey] = exportPrimitiveValue(item)
+		}
+		body.SetAttributeValue(k, cty.ObjectVal(elems))
+	default:
+		if address, ok := references[fmt.Sprint(v)]; ok {
+			body.SetAttributeTraversal(k, ExportTraversal(address))
+			return
+		}
+		body.SetAttributeRaw(k, exportValueTokens(exportPrimitiveValue(v)))
+	}
+}
+
+func exportPrimitiveValue(v interface{}) cty.Value {
+	switch value := v.(type) {
+	case bool:
+		return cty.BoolVal(value)
+	case int:
+		return cty.NumberIntVal(int64(value))
+	case float64:
+		return cty.NumberFloatVal(value)
+	case string:
+		return cty.StringVal(value)
+	}
+	return cty.StringVal(fmt.Sprint(v))
+}
+
+// exportValueTokens renders multi-line strings as heredocs, other values as literals
+func exportValueTokens(v cty.Value) hclwrite.Tokens {
+	if v.Type() != cty.String {
+		return hclwrite.TokensForValue(v)
+	}
+	value := v.AsString()
+	if !strings.HasSuffix(value, "\n") || strings.Count(value, "\n") < 2 {
+		return hclwrite.TokensForValue(v)
+	}
+	lines := strings.Split(strings.TrimSuffix(value, "\n"), "\n")
+	marker := "EOT"
+	for containsLine(lines, marker) {
+		marker += "_"
+	}
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(value)
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + marker + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(marker)},
+	}
+}
+
+func containsLine(lines []string, marker string) bool {
+	for _, line := range lines {
+		if strings.TrimSpace(line) == marker {
+			return true
+		}
+	}
+	return false
+}
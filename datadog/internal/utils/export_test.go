@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestExportResourceName(t *testing.T) {
+	used := make(map[string]bool)
+	cases := []struct {
+		name     string
+		id       string
+		expected string
+	}{
+		{"CPU is high on {{host.name}}", "1", "cpu_is_high_on_host_name"},
+		{"CPU is high on {{host.name}}", "2", "cpu_is_high_on_host_name_2"},
+		{"5xx errors", "3", "_5xx_errors"},
+		{"🔥", "abc-def-ghi", "abc_def_ghi"},
+	}
+	for _, tc := range cases {
+		if name := ExportResourceName(tc.name, tc.id, used); name != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.expected, name)
+		}
+	}
+}
+
+func TestWriteResourceBody(t *testing.T) {
+	s := map[string]*schema.Schema{
+		"name":        {Type: schema.TypeString, Required: true},
+		"id_computed": {Type: schema.TypeString, Computed: true},
+		"deprecated":  {Type: schema.TypeString, Optional: true, Deprecated: "Use `name` instead."},
+		"enabled":     {Type: schema.TypeBool, Optional: true, Default: true},
+		"validate":    {Type: schema.TypeBool, Optional: true, Default: true},
+		"priority":    {Type: schema.TypeInt, Optional: true},
+		"message":     {Type: schema.TypeString, Optional: true},
+		"monitor_ids": {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeInt}},
+		"threshold": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"target":    {Type: schema.TypeFloat, Required: true},
+			"timeframe": {Type: schema.TypeString, Optional: true},
+		}}},
+		"empty": {Type: schema.TypeList, Optional: true, Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"value": {Type: schema.TypeString, Optional: true},
+		}}},
+	}
+	values := map[string]interface{}{
+		"name":        "SLO",
+		"id_computed": "abc",
+		"deprecated":  "SLO",
+		"enabled":     false,
+		"validate":    true,
+		"priority":    0,
+		"message":     "Latency is high\n${value}\n",
+		"monitor_ids": []interface{}{123, 456},
+		"threshold":   []interface{}{map[string]interface{}{"target": 99.5, "timeframe": "7d"}},
+		"empty":       []interface{}{map[string]interface{}{"value": ""}},
+	}
+	references := map[string]map[string]string{"monitor_ids": {"123": "datadog_monitor.latency.id"}}
+
+	f := hclwrite.NewEmptyFile()
+	WriteResourceBody(f.Body(), s, values, references)
+	expected := `enabled     = false
+message     = <<EOT
+Latency is high
+$${value}
+EOT
+monitor_ids = [datadog_monitor.latency.id, 456]
+name        = "SLO"
+
+threshold {
+  target    = 99.5
+  timeframe = "7d"
+}
+`
+	if output := string(f.Bytes()); output != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, output)
+	}
+}
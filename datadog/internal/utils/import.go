@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ParseImportFilter splits an import ID of the form `<key>=<value>`, e.g. `name=My monitor`. It returns an
+// empty key for plain IDs.
+func ParseImportFilter(id string) (string, string) {
+	key, value, found := strings.Cut(id, "=")
+	if !found {
+		return "", id
+	}
+	return key, value
+}
+
+// UniqueImportMatch returns the ID of the only object matched by an import filter, given the names of the
+// matching objects by ID. It fails when zero or several objects match.
+func UniqueImportMatch(objectType string, filter string, matches map[string]string) (string, error) {
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no %s matches %s", objectType, filter)
+	case 1:
+		for id := range matches {
+			return id, nil
+		}
+	}
+	descriptions := make([]string, 0, len(matches))
+	for id, name := range matches {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", id, name))
+	}
+	sort.Strings(descriptions)
+	return "", fmt.Errorf("%d %ss match %s, import them by ID instead: %s", len(matches), objectType, filter, strings.Join(descriptions, ", "))
+}
@@ -0,0 +1,57 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseImportFilter(t *testing.T) {
+	cases := map[string][2]string{
+		"2081":               {"", "2081"},
+		"sv7-gyh-kas":        {"", "sv7-gyh-kas"},
+		"name=My monitor":    {"name", "My monitor"},
+		"query=tag:team:a=b": {"query", "tag:team:a=b"},
+		"title=":             {"title", ""},
+	}
+	for id, expected := range cases {
+		key, value := ParseImportFilter(id)
+		if key != expected[0] || value != expected[1] {
+			t.Errorf("%s: expected %v, got %s %s", id, expected, key, value)
+		}
+	}
+}
+
+func TestUniqueImportMatch(t *testing.T) {
+	cases := map[string]struct {
+		matches map[string]string
+		id      string
+		err     string
+	}{
+		"no match": {
+			matches: map[string]string{},
+			err:     "no monitor matches name=foo",
+		},
+		"one match": {
+			matches: map[string]string{"123": "foo"},
+			id:      "123",
+		},
+		"several matches": {
+			matches: map[string]string{"456": "foo", "123": "foo"},
+			err:     "2 monitors match name=foo, import them by ID instead: 123 (foo), 456 (foo)",
+		},
+	}
+	for name, tc := range cases {
+		id, err := UniqueImportMatch("monitor", "name=foo", tc.matches)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%s: expected error %q, got %v", name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+		if id != tc.id {
+			t.Errorf("%s: expected %s, got %s", name, tc.id, id)
+		}
+	}
+}
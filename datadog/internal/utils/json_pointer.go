@@ -0,0 +1,130 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseJSONPointer splits a JSON pointer (RFC 6901) such as `/data/attributes/name` into its tokens
+func ParseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q, it must start with `/`", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// GetJSONPointer returns the value of a JSON document at the given JSON pointer
+func GetJSONPointer(document interface{}, pointer string) (interface{}, error) {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	value := document
+	for _, token := range tokens {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[token]; !ok {
+				return nil, fmt.Errorf("%s: key %q not found", pointer, token)
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(v) {
+				return nil, fmt.Errorf("%s: invalid index %q", pointer, token)
+			}
+			value = v[i]
+		default:
+			return nil, fmt.Errorf("%s: %q is not an object or an array", pointer, token)
+		}
+	}
+	return value, nil
+}
+
+// DeleteJSONPointer removes the object key at the given JSON pointer from a JSON document, if it exists
+func DeleteJSONPointer(document interface{}, pointer string) error {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+	parent, err := GetJSONPointer(document, pointer[:strings.LastIndex(pointer, "/")])
+	if err != nil {
+		// Nothing to delete
+		return nil
+	}
+	if m, ok := parent.(map[string]interface{}); ok {
+		delete(m, tokens[len(tokens)-1])
+	}
+	return nil
+}
+
+// SetJSONPointer sets the value at the given JSON pointer of a JSON document. The parent of the value must exist,
+// array elements can only be replaced.
+func SetJSONPointer(document interface{}, pointer string, value interface{}) error {
+	tokens, err := ParseJSONPointer(pointer)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("can't set the root of a JSON document")
+	}
+	parent, err := GetJSONPointer(document, pointer[:strings.LastIndex(pointer, "/")])
+	if err != nil {
+		return err
+	}
+	token := tokens[len(tokens)-1]
+	switch v := parent.(type) {
+	case map[string]interface{}:
+		v[token] = value
+	case []interface{}:
+		i, err := strconv.Atoi(token)
+		if err != nil || i < 0 || i >= len(v) {
+			return fmt.Errorf("%s: invalid index %q", pointer, token)
+		}
+		v[i] = value
+	default:
+		return fmt.Errorf("%s: %q is not an object or an array", pointer, token)
+	}
+	return nil
+}
+
+// FlattenJSONPointers returns the JSON encoded leaves of a JSON document by JSON pointer, e.g.
+// `/options/thresholds/critical`. Empty objects and arrays are leaves.
+func FlattenJSONPointers(document interface{}) map[string]string {
+	leaves := make(map[string]string)
+	flattenJSONPointers(document, "", leaves)
+	return leaves
+}
+
+func flattenJSONPointers(value interface{}, pointer string, leaves map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) > 0 {
+			for k, item := range v {
+				token := strings.ReplaceAll(strings.ReplaceAll(k, "~", "~0"), "/", "~1")
+				flattenJSONPointers(item, pointer+"/"+token, leaves)
+			}
+			return
+		}
+	case []interface{}:
+		if len(v) > 0 {
+			for i, item := range v {
+				flattenJSONPointers(item, pointer+"/"+strconv.Itoa(i), leaves)
+			}
+			return
+		}
+	}
+	encoded, _ := json.Marshal(value)
+	leaves[pointer] = string(encoded)
+}
@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestJSONPointer(t *testing.T) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(`{"data": {"id": "abc", "attributes": {"a/b": 1, "tags": ["x", "y"]}}}`), &document); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		pointer  string
+		expected interface{}
+		err      bool
+	}{
+		"root":          {"", document, false},
+		"nested key":    {"/data/id", "abc", false},
+		"escaped key":   {"/data/attributes/a~1b", float64(1), false},
+		"array index":   {"/data/attributes/tags/1", "y", false},
+		"missing key":   {"/data/type", nil, true},
+		"invalid index": {"/data/attributes/tags/2", nil, true},
+		"not a pointer": {"data/id", nil, true},
+	}
+	for name, tc := range cases {
+		value, err := GetJSONPointer(document, tc.pointer)
+		if tc.err != (err != nil) {
+			t.Errorf("%s: unexpected error '%v'", name, err)
+		}
+		if !reflect.DeepEqual(value, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, value)
+		}
+	}
+
+	for _, pointer := range []string{"/data/id", "/data/attributes/a~1b", "/data/missing/key"} {
+		if err := DeleteJSONPointer(document, pointer); err != nil {
+			t.Errorf("%s: unexpected error '%v'", pointer, err)
+		}
+	}
+	remaining, _ := json.Marshal(document)
+	if string(remaining) != `{"data":{"attributes":{"tags":["x","y"]}}}` {
+		t.Errorf("unexpected document after deletion: %s", remaining)
+	}
+
+	for _, pointer := range []string{"/data/id", "/data/attributes/tags/0"} {
+		if err := SetJSONPointer(document, pointer, "z"); err != nil {
+			t.Errorf("%s: unexpected error '%v'", pointer, err)
+		}
+	}
+	for _, pointer := range []string{"", "/data/missing/key", "/data/attributes/tags/2"} {
+		if err := SetJSONPointer(document, pointer, "z"); err == nil {
+			t.Errorf("%s: expected an error", pointer)
+		}
+	}
+	updated, _ := json.Marshal(document)
+	if string(updated) != `{"data":{"attributes":{"tags":["z","y"]},"id":"z"}}` {
+		t.Errorf("unexpected document after update: %s", updated)
+	}
+}
+
+func TestFlattenJSONPointers(t *testing.T) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(`{"name": "n", "tags": ["x"], "options": {"thresholds": {"critical": 2.0}, "silenced": {}, "a/b~c": null}}`), &document); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		"/name":                        `"n"`,
+		"/tags/0":                      `"x"`,
+		"/options/thresholds/critical": "2",
+		"/options/silenced":            "{}",
+		"/options/a~1b~0c":             "null",
+	}
+	if leaves := FlattenJSONPointers(document); !reflect.DeepEqual(leaves, expected) {
+		t.Errorf("expected %v, got %v", expected, leaves)
+	}
+}
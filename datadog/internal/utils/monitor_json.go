@@ -0,0 +1,77 @@
+package utils
+
+import (
+	"reflect"
+	"sort"
+)
+
+// monitorJSONDefaults lists the values filled in by the API when a monitor field isn't set
+var monitorJSONDefaults = map[string]interface{}{
+	"/message":                          "",
+	"/tags":                             []interface{}{},
+	"/options/escalation_message":       "",
+	"/options/groupby_simple_monitor":   false,
+	"/options/include_tags":             true,
+	"/options/locked":                   false,
+	"/options/new_host_delay":           float64(300),
+	"/options/notification_preset_name": "show_all",
+	"/options/notify_audit":             false,
+	"/options/notify_by":                []interface{}{},
+	"/options/notify_no_data":           false,
+	"/options/on_missing_data":          "default",
+	"/options/renotify_statuses":        []interface{}{},
+	"/options/silenced":                 map[string]interface{}{},
+	"/options/threshold_windows":        map[string]interface{}{},
+	"/options/thresholds":               map[string]interface{}{},
+	"/options/variables":                []interface{}{},
+}
+
+// monitorJSONUnorderedArrays lists the monitor arrays whose order doesn't matter
+var monitorJSONUnorderedArrays = []string{
+	"/tags",
+	"/restricted_roles",
+	"/options/notify_by",
+	"/options/renotify_statuses",
+}
+
+// NormalizeMonitorJSON drops the null fields and the fields set to the value the API defaults them to, and
+// sorts the arrays whose order doesn't matter, so that equivalent monitor definitions are equal
+func NormalizeMonitorJSON(monitor map[string]interface{}) {
+	deleteNullValues(monitor)
+	for pointer, defaultValue := range monitorJSONDefaults {
+		if value, err := GetJSONPointer(monitor, pointer); err == nil && reflect.DeepEqual(value, defaultValue) {
+			DeleteJSONPointer(monitor, pointer)
+		}
+	}
+	if options, ok := monitor["options"].(map[string]interface{}); ok && len(options) == 0 {
+		delete(monitor, "options")
+	}
+	for _, pointer := range monitorJSONUnorderedArrays {
+		if value, err := GetJSONPointer(monitor, pointer); err == nil {
+			if items, ok := value.([]interface{}); ok {
+				sort.SliceStable(items, func(i, j int) bool {
+					a, aOk := items[i].(string)
+					b, bOk := items[j].(string)
+					return aOk && bOk && a < b
+				})
+			}
+		}
+	}
+}
+
+func deleteNullValues(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if item == nil {
+				delete(v, k)
+			} else {
+				deleteNullValues(item)
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			deleteNullValues(item)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNormalizeMonitorJSON(t *testing.T) {
+	cases := map[string]struct {
+		monitor  string
+		expected string
+	}{
+		"api defaults": {
+			`{"name": "n", "type": "metric alert", "query": "q", "message": "", "tags": [], "priority": null, "restricted_roles": null,
+			  "options": {"notify_audit": false, "include_tags": true, "new_host_delay": 300, "silenced": {}, "notify_no_data": false,
+			  "thresholds": {"critical": 1.0, "warning": null}, "notification_preset_name": "show_all", "on_missing_data": "default"}}`,
+			`{"name":"n","options":{"thresholds":{"critical":1}},"query":"q","type":"metric alert"}`,
+		},
+		"non default values": {
+			`{"name": "n", "options": {"notify_audit": true, "include_tags": false, "new_host_delay": 600}}`,
+			`{"name":"n","options":{"include_tags":false,"new_host_delay":600,"notify_audit":true}}`,
+		},
+		"empty options": {
+			`{"name": "n", "options": {"include_tags": true, "thresholds": {}}}`,
+			`{"name":"n"}`,
+		},
+		"unordered arrays": {
+			`{"name": "n", "tags": ["b", "a"], "options": {"notify_by": ["env", "cluster"], "variables": [{"name": "b"}, {"name": "a"}]}}`,
+			`{"name":"n","options":{"notify_by":["cluster","env"],"variables":[{"name":"b"},{"name":"a"}]},"tags":["a","b"]}`,
+		},
+	}
+	for name, tc := range cases {
+		var monitor map[string]interface{}
+		if err := json.Unmarshal([]byte(tc.monitor), &monitor); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		NormalizeMonitorJSON(monitor)
+		normalized, _ := json.Marshal(monitor)
+		if string(normalized) != tc.expected {
+			t.Errorf("%s: expected %s, got %s", name, tc.expected, normalized)
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// MonitorNotificationSection is a part of a monitor message: a free text and the handles to notify
+type MonitorNotificationSection struct {
+	Text       string
+	Recipients []string
+}
+
+// MonitorNotification is the structured definition of a monitor message. The transition sections are
+// only rendered when the monitor goes through the matching transition.
+type MonitorNotification struct {
+	Header     string
+	Recipients []string
+	Alert      *MonitorNotificationSection
+	Warning    *MonitorNotificationSection
+	NoData     *MonitorNotificationSection
+	Recovery   *MonitorNotificationSection
+	Footer     string
+	Escalation *MonitorNotificationSection
+}
+
+var templateVariableRegex = regexp.MustCompile(`\{\{\{?([^{}]*)\}?\}\}`)
+
+// ValidateMessageText checks the template variable references of a message text, e.g. `{{value}}` or
+// `{{host.name}}`. Conditional sections aren't allowed, as they are rendered from the transitions.
+func ValidateMessageText(text string) error {
+	references := templateVariableRegex.FindAllStringSubmatch(text, -1)
+	if strings.Count(text, "{{") != len(references) {
+		return fmt.Errorf("unbalanced template variable reference in %q", text)
+	}
+	for _, reference := range references {
+		variable := strings.TrimSpace(reference[1])
+		if variable == "" {
+			return fmt.Errorf("empty template variable reference in %q", text)
+		}
+		if strings.ContainsAny(variable[:1], "#^/") || variable == "else" {
+			return fmt.Errorf("conditional section %s isn't allowed, use the transition blocks instead", reference[0])
+		}
+	}
+	return nil
+}
+
+func renderMonitorNotificationSection(section MonitorNotificationSection) (string, error) {
+	if err := ValidateMessageText(section.Text); err != nil {
+		return "", err
+	}
+	var lines []string
+	if text := strings.TrimSpace(section.Text); text != "" {
+		lines = append(lines, text)
+	}
+	if len(section.Recipients) > 0 {
+		lines = append(lines, strings.Join(section.Recipients, " "))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Message renders the monitor message
+func (n MonitorNotification) Message() (string, error) {
+	var parts []string
+	header, err := renderMonitorNotificationSection(MonitorNotificationSection{Text: n.Header, Recipients: n.Recipients})
+	if err != nil {
+		return "", err
+	}
+	if header != "" {
+		parts = append(parts, header)
+	}
+	transitions := []struct {
+		variable string
+		section  *MonitorNotificationSection
+	}{
+		{"is_alert", n.Alert},
+		{"is_warning", n.Warning},
+		{"is_no_data", n.NoData},
+		{"is_recovery", n.Recovery},
+	}
+	for _, transition := range transitions {
+		if transition.section == nil {
+			continue
+		}
+		section, err := renderMonitorNotificationSection(*transition.section)
+		if err != nil {
+			return "", err
+		}
+		if section != "" {
+			parts = append(parts, fmt.Sprintf("{{#%s}}\n%s\n{{/%s}}", transition.variable, section, transition.variable))
+		}
+	}
+	footer, err := renderMonitorNotificationSection(MonitorNotificationSection{Text: n.Footer})
+	if err != nil {
+		return "", err
+	}
+	if footer != "" {
+		parts = append(parts, footer)
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// EscalationMessage renders the monitor re-notification message
+func (n MonitorNotification) EscalationMessage() (string, error) {
+	if n.Escalation == nil {
+		return "", nil
+	}
+	return renderMonitorNotificationSection(*n.Escalation)
+}
+
+// Handles returns the sorted list of the handles notified by any section
+func (n MonitorNotification) Handles() []string {
+	unique := make(map[string]bool)
+	for _, handle := range n.Recipients {
+		unique[handle] = true
+	}
+	for _, section := range []*MonitorNotificationSection{n.Alert, n.Warning, n.NoData, n.Recovery, n.Escalation} {
+		if section == nil {
+			continue
+		}
+		for _, handle := range section.Recipients {
+			unique[handle] = true
+		}
+	}
+	handles := make([]string, 0, len(unique))
+	for handle := range unique {
+		handles = append(handles, handle)
+	}
+	sort.Strings(handles)
+	return handles
+}
+
+// ParseNotificationHandle splits an integration handle such as `@slack-account-channel` into the
+// integration and the rest of the handle. Other handles, e.g. emails, return an empty integration.
+func ParseNotificationHandle(handle string) (string, string) {
+	for _, integration := range []string{"slack", "pagerduty", "opsgenie", "webhook"} {
+		if name := strings.TrimPrefix(handle, "@"+integration+"-"); name != handle {
+			return integration, name
+		}
+	}
+	return "", strings.TrimPrefix(handle, "@")
+}
@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMonitorNotificationMessage(t *testing.T) {
+	cases := map[string]struct {
+		notification MonitorNotification
+		message      string
+		escalation   string
+		err          bool
+	}{
+		"header only": {
+			notification: MonitorNotification{Header: " CPU is high on {{host.name}} ", Recipients: []string{"@team@example.com"}},
+			message:      "CPU is high on {{host.name}}\n@team@example.com",
+		},
+		"transitions": {
+			notification: MonitorNotification{
+				Header:   "CPU is {{value}}",
+				Alert:    &MonitorNotificationSection{Text: "Page the on-call", Recipients: []string{"@pagerduty-web", "@slack-ops"}},
+				Warning:  &MonitorNotificationSection{Recipients: []string{"@slack-ops"}},
+				NoData:   &MonitorNotificationSection{},
+				Recovery: &MonitorNotificationSection{Text: "Back to normal", Recipients: []string{"@pagerduty-web"}},
+				Footer:   "See the runbook",
+			},
+			message: "CPU is {{value}}\n\n" +
+				"{{#is_alert}}\nPage the on-call\n@pagerduty-web @slack-ops\n{{/is_alert}}\n\n" +
+				"{{#is_warning}}\n@slack-ops\n{{/is_warning}}\n\n" +
+				"{{#is_recovery}}\nBack to normal\n@pagerduty-web\n{{/is_recovery}}\n\n" +
+				"See the runbook",
+		},
+		"escalation": {
+			notification: MonitorNotification{
+				Header:     "CPU is high",
+				Escalation: &MonitorNotificationSection{Text: "Still high", Recipients: []string{"@pagerduty-web"}},
+			},
+			message:    "CPU is high",
+			escalation: "Still high\n@pagerduty-web",
+		},
+		"conditional in text": {
+			notification: MonitorNotification{Header: "{{#is_alert}}CPU is high{{/is_alert}}"},
+			err:          true,
+		},
+		"conditional in transition": {
+			notification: MonitorNotification{Alert: &MonitorNotificationSection{Text: "{{^is_warning}}CPU is high{{/is_warning}}"}},
+			err:          true,
+		},
+		"unbalanced reference": {
+			notification: MonitorNotification{Footer: "CPU is {{value"},
+			err:          true,
+		},
+	}
+	for name, tc := range cases {
+		message, err := tc.notification.Message()
+		if tc.err {
+			if err == nil {
+				t.Errorf("%s: expected an error, got %q", name, message)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+			continue
+		}
+		if message != tc.message {
+			t.Errorf("%s: expected message %q, got %q", name, tc.message, message)
+		}
+		escalation, err := tc.notification.EscalationMessage()
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+		if escalation != tc.escalation {
+			t.Errorf("%s: expected escalation message %q, got %q", name, tc.escalation, escalation)
+		}
+	}
+}
+
+func TestValidateMessageText(t *testing.T) {
+	cases := map[string]bool{
+		"":                                    true,
+		"CPU is {{value}} on {{ host.name }}": true,
+		"Raw {{{log.message}}}":               true,
+		"{{#is_alert}}alert{{/is_alert}}":     false,
+		"{{else}}":                            false,
+		"{{ }}":                               false,
+		"CPU is {{value} on {{host.name}}":    false,
+		"{{eval \"value*100\"}} percent":      true,
+		"{{local_time 'last_triggered_at' 'Europe/Paris'}}": true,
+	}
+	for text, valid := range cases {
+		if err := ValidateMessageText(text); (err == nil) != valid {
+			t.Errorf("%q: expected valid=%v, got %v", text, valid, err)
+		}
+	}
+}
+
+func TestMonitorNotificationHandles(t *testing.T) {
+	notification := MonitorNotification{
+		Recipients: []string{"@slack-ops"},
+		Alert:      &MonitorNotificationSection{Recipients: []string{"@pagerduty-web", "@slack-ops"}},
+		Escalation: &MonitorNotificationSection{Recipients: []string{"@webhook-jira"}},
+	}
+	expected := []string{"@pagerduty-web", "@slack-ops", "@webhook-jira"}
+	if handles := notification.Handles(); !reflect.DeepEqual(handles, expected) {
+		t.Errorf("expected %v, got %v", expected, handles)
+	}
+}
+
+func TestParseNotificationHandle(t *testing.T) {
+	cases := map[string][2]string{
+		"@slack-account-my-channel": {"slack", "account-my-channel"},
+		"@pagerduty-web":            {"pagerduty", "web"},
+		"@opsgenie-ops-team":        {"opsgenie", "ops-team"},
+		"@webhook-jira":             {"webhook", "jira"},
+		"@user@example.com":         {"", "user@example.com"},
+		"@teams-channel":            {"", "teams-channel"},
+	}
+	for handle, expected := range cases {
+		integration, name := ParseNotificationHandle(handle)
+		if integration != expected[0] || name != expected[1] {
+			t.Errorf("%s: expected %v, got %s %s", handle, expected, integration, name)
+		}
+	}
+}
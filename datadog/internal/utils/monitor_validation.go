@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MonitorDefinition is the part of a monitor checked by ValidateMonitorDefinition. Fields whose value
+// isn't known yet are left empty and their checks are skipped.
+type MonitorDefinition struct {
+	Type  string
+	Query string
+	// Thresholds by name, e.g. `critical` or `warning_recovery`
+	Thresholds map[string]float64
+	// Options lists the options set in the configuration, e.g. `new_group_delay`
+	Options      []string
+	NotifyBy     []string
+	HasVariables bool
+}
+
+// monitorOptionTypes lists the options only valid for some monitor types
+var monitorOptionTypes = map[string][]string{
+	"enable_logs_sample":     {"log alert"},
+	"groupby_simple_monitor": {"log alert"},
+	"enable_samples":         {"ci-pipelines alert", "ci-tests alert"},
+}
+
+// monitorOptionExcludedTypes lists the options invalid for some monitor types
+var monitorOptionExcludedTypes = map[string][]string{
+	"new_group_delay": {"log alert"},
+}
+
+// notifyByTypes lists the monitor types whose query group-by is parsed to check `notify_by`
+var notifyByTypes = []string{"metric alert", "query alert", "service check", "log alert"}
+
+var (
+	monitorQueryComparatorRegex = regexp.MustCompile(`(>=|<=|>|<)\s*(-?[0-9]*\.?[0-9]+(?:[eE][-+]?[0-9]+)?)\s*$`)
+	monitorQueryGroupByRegexes  = []*regexp.Regexp{
+		// Metric queries, e.g. `avg:system.cpu.user{*} by {host,env}`
+		regexp.MustCompile(`\bby\s*\{([^}]*)\}`),
+		// Log and service check queries, e.g. `.by("host","env")`
+		regexp.MustCompile(`\.by\(([^)]*)\)`),
+	}
+)
+
+// ParseMonitorQueryComparator returns the comparator and threshold ending a monitor query, e.g. `>` and 5
+// for `avg(last_5m):avg:system.load.1{*} > 5`
+func ParseMonitorQueryComparator(query string) (string, float64, bool) {
+	match := monitorQueryComparatorRegex.FindStringSubmatch(query)
+	if match == nil {
+		return "", 0, false
+	}
+	threshold, err := strconv.ParseFloat(match[2], 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return match[1], threshold, true
+}
+
+// MonitorQueryGroupBy returns the sorted group-by tags of a monitor query
+func MonitorQueryGroupBy(query string) []string {
+	unique := make(map[string]bool)
+	for _, re := range monitorQueryGroupByRegexes {
+		for _, match := range re.FindAllStringSubmatch(query, -1) {
+			for _, tag := range strings.FieldsFunc(match[1], func(r rune) bool { return r == ',' || r == '"' || r == ' ' }) {
+				unique[tag] = true
+			}
+		}
+	}
+	tags := make([]string, 0, len(unique))
+	for tag := range unique {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// ValidateMonitorDefinition checks a monitor definition without the API: the thresholds order against the
+// query comparator, the options valid for the monitor type, `notify_by` against the query group-by and the
+// threshold windows against anomaly queries.
+func ValidateMonitorDefinition(m MonitorDefinition) []error {
+	var errs []error
+	if m.Query != "" {
+		errs = append(errs, validateMonitorThresholds(m.Query, m.Thresholds)...)
+	}
+	if m.Type != "" {
+		for _, option := range m.Options {
+			if types, ok := monitorOptionTypes[option]; ok && !containsString(types, m.Type) {
+				errs = append(errs, fmt.Errorf("%s is only valid for monitors of type %s, not %s", option, strings.Join(types, ", "), m.Type))
+			}
+			if types, ok := monitorOptionExcludedTypes[option]; ok && containsString(types, m.Type) {
+				errs = append(errs, fmt.Errorf("%s isn't valid for monitors of type %s", option, m.Type))
+			}
+		}
+	}
+	if m.Query != "" && containsString(m.Options, "monitor_threshold_windows") && !strings.Contains(m.Query, "anomalies(") {
+		errs = append(errs, fmt.Errorf("monitor_threshold_windows can only be used with anomaly queries"))
+	}
+	if m.Query != "" && containsString(notifyByTypes, m.Type) && !m.HasVariables {
+		groupBy := MonitorQueryGroupBy(m.Query)
+		for _, tag := range m.NotifyBy {
+			if tag != "*" && !containsString(groupBy, tag) {
+				errs = append(errs, fmt.Errorf("notify_by tag %q isn't grouped by in the query, expected one of %v", tag, groupBy))
+			}
+		}
+	}
+	return errs
+}
+
+func validateMonitorThresholds(query string, thresholds map[string]float64) []error {
+	comparator, queryThreshold, ok := ParseMonitorQueryComparator(query)
+	if !ok {
+		return nil
+	}
+	var errs []error
+	if critical, ok := thresholds["critical"]; ok && critical != queryThreshold {
+		errs = append(errs, fmt.Errorf("critical threshold (%v) must match the threshold of the query (%v)", critical, queryThreshold))
+	}
+	above := strings.HasPrefix(comparator, ">")
+	// Each pair of thresholds must be ordered in the direction of the comparator
+	for _, pair := range [][2]string{{"warning", "critical"}, {"critical_recovery", "critical"}, {"warning_recovery", "warning"}} {
+		lower, lowerOk := thresholds[pair[0]]
+		higher, higherOk := thresholds[pair[1]]
+		if !lowerOk || !higherOk {
+			continue
+		}
+		if above && lower >= higher {
+			errs = append(errs, fmt.Errorf("%s threshold (%v) must be lower than the %s threshold (%v) with the %s comparator", pair[0], lower, pair[1], higher, comparator))
+		} else if !above && lower <= higher {
+			errs = append(errs, fmt.Errorf("%s threshold (%v) must be higher than the %s threshold (%v) with the %s comparator", pair[0], lower, pair[1], higher, comparator))
+		}
+	}
+	return errs
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,115 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateMonitorDefinition(t *testing.T) {
+	cases := map[string]struct {
+		definition MonitorDefinition
+		errs       []string
+	}{
+		"valid metric alert": {
+			definition: MonitorDefinition{
+				Type:       "metric alert",
+				Query:      "avg(last_5m):avg:system.cpu.user{*} by {host,env} > 90",
+				Thresholds: map[string]float64{"critical": 90, "warning": 80, "critical_recovery": 85, "warning_recovery": 75},
+				Options:    []string{"new_group_delay"},
+				NotifyBy:   []string{"env"},
+			},
+		},
+		"below comparator": {
+			definition: MonitorDefinition{
+				Type:       "metric alert",
+				Query:      "avg(last_5m):avg:system.disk.free{*} <= 10",
+				Thresholds: map[string]float64{"critical": 10, "warning": 5, "critical_recovery": 15},
+			},
+			errs: []string{"warning threshold (5) must be higher than the critical threshold (10) with the <= comparator"},
+		},
+		"critical not matching the query": {
+			definition: MonitorDefinition{
+				Type:       "metric alert",
+				Query:      "avg(last_5m):avg:system.cpu.user{*} > 90",
+				Thresholds: map[string]float64{"critical": 95, "warning": 96},
+			},
+			errs: []string{
+				"critical threshold (95) must match the threshold of the query (90)",
+				"warning threshold (96) must be lower than the critical threshold (95) with the > comparator",
+			},
+		},
+		"options of other types": {
+			definition: MonitorDefinition{
+				Type:    "log alert",
+				Query:   `logs("service:web").index("*").rollup("count").last("5m") > 100`,
+				Options: []string{"enable_logs_sample", "new_group_delay", "enable_samples"},
+			},
+			errs: []string{
+				"new_group_delay isn't valid for monitors of type log alert",
+				"enable_samples is only valid for monitors of type ci-pipelines alert, ci-tests alert, not log alert",
+			},
+		},
+		"threshold windows without anomalies": {
+			definition: MonitorDefinition{
+				Type:    "query alert",
+				Query:   "avg(last_4h):avg:system.load.1{*} > 2",
+				Options: []string{"monitor_threshold_windows"},
+			},
+			errs: []string{"monitor_threshold_windows can only be used with anomaly queries"},
+		},
+		"threshold windows with anomalies": {
+			definition: MonitorDefinition{
+				Type:    "query alert",
+				Query:   "avg(last_4h):anomalies(avg:system.load.1{*}, 'basic', 2) >= 1",
+				Options: []string{"monitor_threshold_windows"},
+			},
+		},
+		"notify_by not grouped by": {
+			definition: MonitorDefinition{
+				Type:     "log alert",
+				Query:    `logs("service:web").index("*").rollup("count").by("service,env").last("5m") > 100`,
+				NotifyBy: []string{"env", "region", "*"},
+			},
+			errs: []string{`notify_by tag "region" isn't grouped by in the query, expected one of [env service]`},
+		},
+		"notify_by with formula variables": {
+			definition: MonitorDefinition{
+				Type:         "query alert",
+				Query:        "formula(\"query1\").last(\"5m\") > 100",
+				NotifyBy:     []string{"env"},
+				HasVariables: true,
+			},
+		},
+		"unknown query and type": {
+			definition: MonitorDefinition{
+				Thresholds: map[string]float64{"critical": 10, "warning": 20},
+				Options:    []string{"enable_samples"},
+				NotifyBy:   []string{"env"},
+			},
+		},
+	}
+	for name, tc := range cases {
+		var errs []string
+		for _, err := range ValidateMonitorDefinition(tc.definition) {
+			errs = append(errs, err.Error())
+		}
+		if !reflect.DeepEqual(errs, tc.errs) {
+			t.Errorf("%s: expected %q, got %q", name, tc.errs, errs)
+		}
+	}
+}
+
+func TestMonitorQueryGroupBy(t *testing.T) {
+	cases := map[string][]string{
+		"avg(last_5m):avg:system.cpu.user{*} by {host,env} > 90":                       {"env", "host"},
+		"avg(last_5m):avg:a{*} by {host} / avg:b{*} by {host, pod} > 1":                {"host", "pod"},
+		`"http.can_connect".over("*").by("host","instance").last(2).count_by_status()`: {"host", "instance"},
+		`logs("*").index("*").rollup("count").by("service").last("5m") > 1`:            {"service"},
+		"avg(last_5m):avg:system.cpu.user{*} > 90":                                     {},
+	}
+	for query, expected := range cases {
+		if groupBy := MonitorQueryGroupBy(query); !reflect.DeepEqual(groupBy, expected) {
+			t.Errorf("%s: expected %v, got %v", query, expected, groupBy)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ResourcePermissions lists the permissions needed to manage a resource type. Resource types
+// that any user with API access can manage are not listed.
+var ResourcePermissions = map[string][]string{
+	"datadog_api_key":                          {"api_keys_write"},
+	"datadog_application_key":                  {"org_app_keys_write"},
+	"datadog_authn_mapping":                    {"user_access_manage"},
+	"datadog_cloud_configuration_rule":         {"security_monitoring_rules_write"},
+	"datadog_dashboard":                        {"dashboards_write"},
+	"datadog_dashboard_json":                   {"dashboards_write"},
+	"datadog_dashboard_list":                   {"dashboards_write"},
+	"datadog_downtime":                         {"monitors_downtime"},
+	"datadog_logs_archive":                     {"logs_write_archives"},
+	"datadog_logs_archive_order":               {"logs_write_archives"},
+	"datadog_logs_custom_pipeline":             {"logs_write_pipelines"},
+	"datadog_logs_index":                       {"logs_modify_indexes"},
+	"datadog_logs_index_order":                 {"logs_modify_indexes"},
+	"datadog_logs_integration_pipeline":        {"logs_write_pipelines"},
+	"datadog_logs_metric":                      {"logs_generate_metrics"},
+	"datadog_logs_pipeline_order":              {"logs_write_pipelines"},
+	"datadog_monitor":                          {"monitors_write"},
+	"datadog_monitor_json":                     {"monitors_write"},
+	"datadog_role":                             {"user_access_manage"},
+	"datadog_security_monitoring_default_rule": {"security_monitoring_rules_write"},
+	"datadog_security_monitoring_filter":       {"security_monitoring_filters_write"},
+	"datadog_security_monitoring_rule":         {"security_monitoring_rules_write"},
+	"datadog_service_account":                  {"user_access_manage"},
+	"datadog_service_level_objective":          {"slos_write"},
+	"datadog_slo_correction":                   {"slos_corrections"},
+	"datadog_synthetics_global_variable":       {"synthetics_global_variable_write"},
+	"datadog_synthetics_private_location":      {"synthetics_private_location_write"},
+	"datadog_synthetics_test":                  {"synthetics_write"},
+	"datadog_user":                             {"user_access_manage"},
+}
+
+// EffectivePermissions returns the permissions granted to an application key: the permissions of
+// its owner, restricted to the key scopes when the key is scoped
+func EffectivePermissions(ownerPermissions []string, scopes []string) map[string]bool {
+	scoped := make(map[string]bool, len(scopes))
+	for _, scope := range scopes {
+		scoped[scope] = true
+	}
+	granted := make(map[string]bool, len(ownerPermissions))
+	for _, permission := range ownerPermissions {
+		if len(scopes) == 0 || scoped[permission] {
+			granted[permission] = true
+		}
+	}
+	return granted
+}
+
+// UnmanageableResourceTypes returns the sorted list of resource types that can't be managed with the
+// granted permissions, along with the missing permissions, e.g. `datadog_logs_index (logs_modify_indexes)`
+func UnmanageableResourceTypes(granted map[string]bool) []string {
+	var unmanageable []string
+	for resourceType, permissions := range ResourcePermissions {
+		var missing []string
+		for _, permission := range permissions {
+			if !granted[permission] {
+				missing = append(missing, permission)
+			}
+		}
+		if len(missing) > 0 {
+			unmanageable = append(unmanageable, fmt.Sprintf("%s (%s)", resourceType, strings.Join(missing, ", ")))
+		}
+	}
+	sort.Strings(unmanageable)
+	return unmanageable
+}
@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEffectivePermissions(t *testing.T) {
+	cases := map[string]struct {
+		ownerPermissions []string
+		scopes           []string
+		expected         map[string]bool
+	}{
+		"unscoped key": {[]string{"monitors_write", "dashboards_write"}, nil, map[string]bool{"monitors_write": true, "dashboards_write": true}},
+		"scoped key":   {[]string{"monitors_write", "dashboards_write"}, []string{"monitors_write", "logs_modify_indexes"}, map[string]bool{"monitors_write": true}},
+		"no owner":     {nil, []string{"monitors_write"}, map[string]bool{}},
+	}
+	for name, tc := range cases {
+		if granted := EffectivePermissions(tc.ownerPermissions, tc.scopes); !reflect.DeepEqual(granted, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, granted)
+		}
+	}
+}
+
+func TestUnmanageableResourceTypes(t *testing.T) {
+	granted := make(map[string]bool)
+	for _, permissions := range ResourcePermissions {
+		for _, permission := range permissions {
+			granted[permission] = true
+		}
+	}
+	if unmanageable := UnmanageableResourceTypes(granted); len(unmanageable) != 0 {
+		t.Errorf("expected every resource type to be manageable, got %v", unmanageable)
+	}
+
+	delete(granted, "logs_modify_indexes")
+	expected := []string{"datadog_logs_index (logs_modify_indexes)", "datadog_logs_index_order (logs_modify_indexes)"}
+	if unmanageable := UnmanageableResourceTypes(granted); !reflect.DeepEqual(unmanageable, expected) {
+		t.Errorf("expected %v, got %v", expected, unmanageable)
+	}
+}
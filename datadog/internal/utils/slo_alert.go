@@ -0,0 +1,120 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// SLOAlertKind is the kind of alert of an `slo alert` monitor
+type SLOAlertKind string
+
+const (
+	SLOAlertBurnRate    SLOAlertKind = "burn_rate"
+	SLOAlertErrorBudget SLOAlertKind = "error_budget"
+)
+
+// MaxSLOAlertLongWindow is the longest long window accepted by burn rate alerts
+const MaxSLOAlertLongWindow = 48 * time.Hour
+
+// SLOAlertQuery is the parsed query of an `slo alert` monitor
+type SLOAlertQuery struct {
+	Kind        SLOAlertKind
+	SLOID       string
+	Timeframe   string
+	LongWindow  string
+	ShortWindow string
+	Threshold   float64
+}
+
+var (
+	sloAlertWindowRegex        = regexp.MustCompile(`^([1-9][0-9]*)([mhd])$`)
+	sloAlertBurnRateQueryRegex = regexp.MustCompile(
+		`^burn_rate\("([^"]+)"\)\.over\("([^"]+)"\)\.long_window\("([^"]+)"\)\.short_window\("([^"]+)"\)\s*>\s*(\S+)$`)
+	sloAlertErrorBudgetQueryRegex = regexp.MustCompile(`^error_budget\("([^"]+)"\)\.over\("([^"]+)"\)\s*>\s*(\S+)$`)
+)
+
+// ParseSLOAlertWindow parses an SLO alert window or timeframe, e.g. `5m`, `1h` or `7d`
+func ParseSLOAlertWindow(window string) (time.Duration, error) {
+	match := sloAlertWindowRegex.FindStringSubmatch(window)
+	if match == nil {
+		return 0, fmt.Errorf("invalid window %q, expected a number of minutes, hours or days, e.g. `5m`, `1h` or `7d`", window)
+	}
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0, err
+	}
+	unit := map[string]time.Duration{"m": time.Minute, "h": time.Hour, "d": 24 * time.Hour}[match[2]]
+	return time.Duration(n) * unit, nil
+}
+
+// ValidateBurnRateWindows checks that the short window is shorter than the long window, itself shorter than
+// the SLO timeframe and than MaxSLOAlertLongWindow
+func ValidateBurnRateWindows(timeframe, longWindow, shortWindow string) error {
+	long, err := ParseSLOAlertWindow(longWindow)
+	if err != nil {
+		return err
+	}
+	short, err := ParseSLOAlertWindow(shortWindow)
+	if err != nil {
+		return err
+	}
+	if long > MaxSLOAlertLongWindow {
+		return fmt.Errorf("long window %s must be at most 48h", longWindow)
+	}
+	if tf, err := ParseSLOAlertWindow(timeframe); err == nil && long >= tf {
+		return fmt.Errorf("long window %s must be shorter than the SLO timeframe %s", longWindow, timeframe)
+	}
+	if short >= long {
+		return fmt.Errorf("short window %s must be shorter than the long window %s", shortWindow, longWindow)
+	}
+	return nil
+}
+
+// MaxSLOBurnRate returns the highest burn rate an SLO of the given target can reach, i.e. when every
+// event or minute is bad
+func MaxSLOBurnRate(target float64) float64 {
+	return 100 / (100 - target)
+}
+
+// Query returns the monitor query of the alert
+func (q SLOAlertQuery) Query() string {
+	threshold := strconv.FormatFloat(q.Threshold, 'f', -1, 64)
+	if q.Kind == SLOAlertErrorBudget {
+		return fmt.Sprintf(`error_budget("%s").over("%s") > %s`, q.SLOID, q.Timeframe, threshold)
+	}
+	return fmt.Sprintf(`burn_rate("%s").over("%s").long_window("%s").short_window("%s") > %s`,
+		q.SLOID, q.Timeframe, q.LongWindow, q.ShortWindow, threshold)
+}
+
+// ParseSLOAlertQuery parses the query of an `slo alert` monitor, it returns false for other queries
+func ParseSLOAlertQuery(query string) (SLOAlertQuery, bool) {
+	if match := sloAlertBurnRateQueryRegex.FindStringSubmatch(query); match != nil {
+		threshold, err := strconv.ParseFloat(match[5], 64)
+		if err != nil {
+			return SLOAlertQuery{}, false
+		}
+		return SLOAlertQuery{
+			Kind:        SLOAlertBurnRate,
+			SLOID:       match[1],
+			Timeframe:   match[2],
+			LongWindow:  match[3],
+			ShortWindow: match[4],
+			Threshold:   threshold,
+		}, true
+	}
+	if match := sloAlertErrorBudgetQueryRegex.FindStringSubmatch(query); match != nil {
+		threshold, err := strconv.ParseFloat(match[3], 64)
+		if err != nil {
+			return SLOAlertQuery{}, false
+		}
+		return SLOAlertQuery{
+			Kind:      SLOAlertErrorBudget,
+			SLOID:     match[1],
+			Timeframe: match[2],
+			Threshold: threshold,
+		}, true
+	}
+	return SLOAlertQuery{}, false
+}
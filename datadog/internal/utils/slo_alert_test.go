@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestValidateBurnRateWindows(t *testing.T) {
+	cases := map[string]struct {
+		timeframe   string
+		longWindow  string
+		shortWindow string
+		err         string
+	}{
+		"valid":                  {"7d", "1h", "5m", ""},
+		"long window too long":   {"30d", "3d", "1h", "long window 3d must be at most 48h"},
+		"longer than timeframe":  {"7d", "7d", "1h", "long window 7d must be at most 48h"},
+		"short not shorter":      {"7d", "1h", "60m", "short window 60m must be shorter than the long window 1h"},
+		"invalid window":         {"7d", "1w", "5m", "invalid window \"1w\", expected a number of minutes, hours or days, e.g. `5m`, `1h` or `7d`"},
+		"unknown timeframe":      {"custom", "6h", "30m", ""},
+		"timeframe not exceeded": {"1d", "1d", "2h", "long window 1d must be shorter than the SLO timeframe 1d"},
+	}
+	for name, tc := range cases {
+		err := ValidateBurnRateWindows(tc.timeframe, tc.longWindow, tc.shortWindow)
+		if (err == nil && tc.err != "") || (err != nil && err.Error() != tc.err) {
+			t.Errorf("%s: expected %q, got %v", name, tc.err, err)
+		}
+	}
+}
+
+func TestSLOAlertQuery(t *testing.T) {
+	cases := map[string]SLOAlertQuery{
+		`burn_rate("abc").over("7d").long_window("1h").short_window("5m") > 14.4`: {
+			Kind: SLOAlertBurnRate, SLOID: "abc", Timeframe: "7d", LongWindow: "1h", ShortWindow: "5m", Threshold: 14.4,
+		},
+		`error_budget("abc").over("30d") > 75`: {
+			Kind: SLOAlertErrorBudget, SLOID: "abc", Timeframe: "30d", Threshold: 75,
+		},
+	}
+	for query, expected := range cases {
+		parsed, ok := ParseSLOAlertQuery(query)
+		if !ok || parsed != expected {
+			t.Errorf("%s: expected %+v, got %+v", query, expected, parsed)
+		}
+		if q := expected.Query(); q != query {
+			t.Errorf("expected %s, got %s", query, q)
+		}
+	}
+	if _, ok := ParseSLOAlertQuery("avg(last_5m):avg:system.load.1{*} > 5"); ok {
+		t.Errorf("expected metric query not to be parsed")
+	}
+}
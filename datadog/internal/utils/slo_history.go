@@ -0,0 +1,13 @@
+package utils
+
+// SLOErrorBudgetRemaining returns the percentage of the error budget of target remaining with the given SLI,
+// negative once the budget is exhausted
+func SLOErrorBudgetRemaining(sli, target float64) float64 {
+	return (sli - target) / (100 - target) * 100
+}
+
+// SLOBurnRate returns the rate the error budget of target is consumed at with the given SLI, relative to the
+// rate consuming exactly the budget over the window, e.g. 2 when the budget would be exhausted in half the window
+func SLOBurnRate(sli, target float64) float64 {
+	return (100 - sli) / (100 - target)
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSLOErrorBudget(t *testing.T) {
+	cases := map[string]struct {
+		sli       float64
+		target    float64
+		remaining float64
+		burnRate  float64
+	}{
+		"untouched budget":  {100, 99.9, 100, 0},
+		"half budget":       {99.95, 99.9, 50, 0.5},
+		"exhausted budget":  {99.9, 99.9, 0, 1},
+		"overspent budget":  {99.7, 99.9, -200, 3},
+		"only errors":       {0, 99, -9900, 100},
+		"low target budget": {97.5, 95, 50, 0.5},
+	}
+	for name, tc := range cases {
+		if remaining := SLOErrorBudgetRemaining(tc.sli, tc.target); math.Abs(remaining-tc.remaining) > 1e-6 {
+			t.Errorf("%s: expected %v remaining, got %v", name, tc.remaining, remaining)
+		}
+		if burnRate := SLOBurnRate(tc.sli, tc.target); math.Abs(burnRate-tc.burnRate) > 1e-6 {
+			t.Errorf("%s: expected a %v burn rate, got %v", name, tc.burnRate, burnRate)
+		}
+	}
+	if maxBurnRate := MaxSLOBurnRate(99.9); math.Abs(maxBurnRate-SLOBurnRate(0, 99.9)) > 1e-6 {
+		t.Errorf("expected the max burn rate %v to be the burn rate without good events", maxBurnRate)
+	}
+}
@@ -0,0 +1,75 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SLOSliSpecification is the `sli_specification` of a time-slice SLO, which isn't supported by the API client yet
+type SLOSliSpecification struct {
+	TimeSlice *SLOTimeSliceSpec `json:"time_slice,omitempty"`
+}
+
+// SLOTimeSliceSpec defines the good minutes of a time-slice SLO: the minutes where the query compared to the
+// threshold is true
+type SLOTimeSliceSpec struct {
+	Comparator           string            `json:"comparator"`
+	Threshold            float64           `json:"threshold"`
+	QueryIntervalSeconds int64             `json:"query_interval_seconds,omitempty"`
+	Query                SLOTimeSliceQuery `json:"query"`
+}
+
+// SLOTimeSliceQuery is the formula and functions metric query of a time-slice SLO
+type SLOTimeSliceQuery struct {
+	Formulas []SLOTimeSliceFormula     `json:"formulas"`
+	Queries  []SLOTimeSliceMetricQuery `json:"queries"`
+}
+
+// SLOTimeSliceFormula is a formula of a time-slice SLO query
+type SLOTimeSliceFormula struct {
+	Formula string `json:"formula"`
+}
+
+// SLOTimeSliceMetricQuery is a metric query of a time-slice SLO query
+type SLOTimeSliceMetricQuery struct {
+	DataSource string `json:"data_source"`
+	Name       string `json:"name"`
+	Query      string `json:"query"`
+}
+
+// SLOTimeSliceQueryIntervals lists the query intervals of time-slice SLOs, in seconds
+var SLOTimeSliceQueryIntervals = []int{60, 300}
+
+var formulaIdentifierRegex = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// ValidateSLOTimeSliceSpec checks that the query names are unique and that the formula only references them
+func ValidateSLOTimeSliceSpec(spec SLOTimeSliceSpec) []error {
+	var errs []error
+	names := make(map[string]bool)
+	for _, q := range spec.Query.Queries {
+		if q.Name == "" {
+			continue
+		}
+		if names[q.Name] {
+			errs = append(errs, fmt.Errorf("query name %q is used more than once", q.Name))
+		}
+		names[q.Name] = true
+	}
+	for _, f := range spec.Query.Formulas {
+		for _, loc := range formulaIdentifierRegex.FindAllStringIndex(f.Formula, -1) {
+			identifier := f.Formula[loc[0]:loc[1]]
+			// Skip the exponents of numbers, e.g. `1e3`, and the function names, e.g. `clamp_min(`
+			if loc[0] > 0 && strings.ContainsRune("0123456789.", rune(f.Formula[loc[0]-1])) {
+				continue
+			}
+			if strings.HasPrefix(strings.TrimLeft(f.Formula[loc[1]:], " "), "(") {
+				continue
+			}
+			if !names[identifier] {
+				errs = append(errs, fmt.Errorf("formula %q references %q, which isn't the name of a query", f.Formula, identifier))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,43 @@
+package utils
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateSLOTimeSliceSpec(t *testing.T) {
+	queries := []SLOTimeSliceMetricQuery{
+		{DataSource: "metrics", Name: "query1", Query: "sum:trace.http.request.errors{service:web}.as_count()"},
+		{DataSource: "metrics", Name: "query2", Query: "sum:trace.http.request.hits{service:web}.as_count()"},
+	}
+	cases := map[string]struct {
+		formulas []SLOTimeSliceFormula
+		queries  []SLOTimeSliceMetricQuery
+		errs     []string
+	}{
+		"valid": {
+			formulas: []SLOTimeSliceFormula{{Formula: "100 * clamp_min(query1, 1e-3) / query2"}},
+			queries:  queries,
+		},
+		"unknown query": {
+			formulas: []SLOTimeSliceFormula{{Formula: "clamp_min(query3, 1) / query1"}},
+			queries:  queries,
+			errs:     []string{`formula "clamp_min(query3, 1) / query1" references "query3", which isn't the name of a query`},
+		},
+		"duplicated name": {
+			formulas: []SLOTimeSliceFormula{{Formula: "query1"}},
+			queries:  append(queries, queries[0]),
+			errs:     []string{`query name "query1" is used more than once`},
+		},
+	}
+	for name, tc := range cases {
+		spec := SLOTimeSliceSpec{Comparator: "<=", Threshold: 1, Query: SLOTimeSliceQuery{Formulas: tc.formulas, Queries: tc.queries}}
+		var errs []string
+		for _, err := range ValidateSLOTimeSliceSpec(spec) {
+			errs = append(errs, err.Error())
+		}
+		if !reflect.DeepEqual(errs, tc.errs) {
+			t.Errorf("%s: expected %q, got %q", name, tc.errs, errs)
+		}
+	}
+}
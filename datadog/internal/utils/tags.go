@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"strings"
 	"unicode"
 	"unicode/utf8"
 )
@@ -100,3 +101,46 @@ func isValidASCIIStartChar(c byte) bool {
 func isValidASCIITagChar(c byte) bool {
 	return isValidASCIIStartChar(c) || ('0' <= c && c <= '9') || c == '.' || c == '/' || c == '-'
 }
+
+// tagKey returns the normalized key of a `key:value` tag
+func tagKey(tag string) string {
+	return strings.SplitN(NormalizeTag(tag), ":", 2)[0]
+}
+
+// MergeDefaultTags appends the default tags whose key is not already used in tags
+func MergeDefaultTags(defaultTags []string, tags []string) []string {
+	merged := append([]string{}, tags...)
+	keys := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		keys[tagKey(tag)] = true
+	}
+	for _, tag := range defaultTags {
+		if !keys[tagKey(tag)] {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
+}
+
+// RemoveDefaultTags removes the default tags from the tags returned by the API, unless they
+// are also part of the configured tags, so that they don't show up as a diff on `tags`
+func RemoveDefaultTags(defaultTags []string, configuredTags []string, tags []string) []string {
+	configured := make(map[string]bool, len(configuredTags))
+	for _, tag := range configuredTags {
+		configured[NormalizeTag(tag)] = true
+	}
+	defaults := make(map[string]bool, len(defaultTags))
+	for _, tag := range defaultTags {
+		defaults[NormalizeTag(tag)] = true
+	}
+
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		normalized := NormalizeTag(tag)
+		if defaults[normalized] && !configured[normalized] {
+			continue
+		}
+		result = append(result, tag)
+	}
+	return result
+}
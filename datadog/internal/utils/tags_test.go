@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -23,3 +24,41 @@ func TestTagNormalization(t *testing.T) {
 		}
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := []string{"env:prod", "managed-by:terraform", "team:core"}
+	cases := map[string]struct {
+		tags     []string
+		expected []string
+	}{
+		"no tags":           {[]string{}, []string{"env:prod", "managed-by:terraform", "team:core"}},
+		"additional tags":   {[]string{"foo:bar"}, []string{"foo:bar", "env:prod", "managed-by:terraform", "team:core"}},
+		"overridden tags":   {[]string{"env:staging", "team"}, []string{"env:staging", "team", "managed-by:terraform"}},
+		"denormalized keys": {[]string{"Env:staging"}, []string{"Env:staging", "managed-by:terraform", "team:core"}},
+	}
+	for name, tc := range cases {
+		if merged := MergeDefaultTags(defaultTags, tc.tags); !reflect.DeepEqual(merged, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, merged)
+		}
+	}
+}
+
+func TestRemoveDefaultTags(t *testing.T) {
+	defaultTags := []string{"env:prod", "team:core"}
+	cases := map[string]struct {
+		configured []string
+		tags       []string
+		expected   []string
+	}{
+		"default tags only":   {[]string{}, []string{"env:prod", "team:core"}, []string{}},
+		"configured tags":     {[]string{"foo:bar"}, []string{"foo:bar", "env:prod", "team:core"}, []string{"foo:bar"}},
+		"overridden tags":     {[]string{"env:staging"}, []string{"env:staging", "team:core"}, []string{"env:staging"}},
+		"configured defaults": {[]string{"env:prod"}, []string{"env:prod", "team:core"}, []string{"env:prod"}},
+		"remote tags":         {[]string{}, []string{"env:prod", "added:outside"}, []string{"added:outside"}},
+	}
+	for name, tc := range cases {
+		if tags := RemoveDefaultTags(defaultTags, tc.configured, tc.tags); !reflect.DeepEqual(tags, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", name, tc.expected, tags)
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// UnparsedObject is an element of an API response that the client couldn't parse, e.g. an enum value
+// or an object type added to the API after the client was released
+type UnparsedObject struct {
+	// Path is the JSON pointer to the element in the response, e.g. `/widgets/3/definition`
+	Path string
+	// Value is the raw JSON value of the element
+	Value interface{}
+}
+
+// FindUnparsedObjects returns every element of an API response that the client couldn't parse. As opposed
+// to datadog.ContainsUnparsedObject, it doesn't stop at the first one, and nested elements of an unparsed
+// element aren't reported separately.
+func FindUnparsedObjects(resp interface{}) []UnparsedObject {
+	var found []UnparsedObject
+	findUnparsedObjects(reflect.ValueOf(resp), "", &found)
+	return found
+}
+
+func findUnparsedObjects(v reflect.Value, path string, found *[]UnparsedObject) {
+	switch v.Kind() {
+	case reflect.Array, reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			findUnparsedObjects(v.Index(i), fmt.Sprintf("%s/%d", path, i), found)
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprint(keys[i].Interface()) < fmt.Sprint(keys[j].Interface())
+		})
+		for _, k := range keys {
+			findUnparsedObjects(v.MapIndex(k), path+"/"+escapeJSONPointerToken(fmt.Sprint(k.Interface())), found)
+		}
+	case reflect.Struct:
+		if u := v.FieldByName("UnparsedObject"); u.IsValid() && !u.IsNil() {
+			*found = append(*found, UnparsedObject{Path: path, Value: u.Interface()})
+			return
+		}
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			field := t.Field(i)
+			if field.Name == "UnparsedObject" {
+				continue
+			}
+			if field.IsExported() {
+				findUnparsedObjects(v.Field(i), path+jsonFieldToken(field), found)
+			} else if field.Name == "value" {
+				// Special case for Nullables
+				if get := v.MethodByName("Get"); get.IsValid() {
+					findUnparsedObjects(get.Call([]reflect.Value{})[0], path, found)
+				}
+			}
+		}
+	case reflect.Interface, reflect.Ptr:
+		if !v.IsNil() {
+			findUnparsedObjects(v.Elem(), path, found)
+		}
+	default:
+		if v.IsValid() {
+			if m := v.MethodByName("IsValid"); m.IsValid() && !m.Call([]reflect.Value{})[0].Bool() {
+				*found = append(*found, UnparsedObject{Path: path, Value: v.Interface()})
+			}
+		}
+	}
+}
+
+// jsonFieldToken returns the JSON pointer token of a struct field, or an empty string for the fields that
+// aren't serialized under their own key, such as the variants of a oneOf
+func jsonFieldToken(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" || name == "-" {
+		return ""
+	}
+	return "/" + escapeJSONPointerToken(name)
+}
+
+func escapeJSONPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// CheckForUnparsedDiag returns a warning for every element of an API response that the client couldn't
+// parse. As opposed to CheckForUnparsed, the rest of the response can still be used. Only use it when the
+// unparsed elements aren't sent back, or are kept as raw JSON like the widgets of dashboards.
+func CheckForUnparsedDiag(resp interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, unparsed := range FindUnparsedObjects(resp) {
+		path := unparsed.Path
+		if path == "" {
+			path = "/"
+		}
+		raw, _ := json.Marshal(unparsed.Value)
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("object contains unparsed element at %s", path),
+			Detail:   fmt.Sprintf("This version of the provider doesn't support this element, which is probably a new API feature: %s", raw),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestFindUnparsedObjects(t *testing.T) {
+	cases := map[string]struct {
+		dashboard string
+		paths     []string
+	}{
+		"parsed": {
+			`{"title": "t", "layout_type": "ordered", "widgets": [{"definition": {"type": "note", "content": "c"}}]}`,
+			nil,
+		},
+		"unknown widget type": {
+			`{"title": "t", "layout_type": "ordered", "widgets": [{"definition": {"type": "note", "content": "c"}}, {"definition": {"type": "new_widget", "foo": "bar"}}]}`,
+			[]string{"/widgets/1/definition"},
+		},
+		"unknown enum value in widgets": {
+			`{"title": "t", "layout_type": "ordered", "widgets": [{"definition": {"type": "timeseries", "requests": [{"q": "avg:system.load.1{*}", "display_type": "new_display"}]}}, {"definition": {"type": "new_widget"}}]}`,
+			[]string{"/widgets/0/definition/requests/0", "/widgets/1/definition"},
+		},
+		"unknown widget type in group": {
+			`{"title": "t", "layout_type": "ordered", "widgets": [{"definition": {"type": "group", "layout_type": "ordered", "widgets": [{"definition": {"type": "new_widget"}}]}}]}`,
+			[]string{"/widgets/0/definition/widgets/0/definition"},
+		},
+	}
+	for name, tc := range cases {
+		var dashboard datadogV1.Dashboard
+		if err := json.Unmarshal([]byte(tc.dashboard), &dashboard); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		var paths []string
+		for _, unparsed := range FindUnparsedObjects(dashboard) {
+			paths = append(paths, unparsed.Path)
+		}
+		if !reflect.DeepEqual(paths, tc.paths) {
+			t.Errorf("%s: expected %v, got %v", name, tc.paths, paths)
+		}
+		diags := CheckForUnparsedDiag(&dashboard)
+		if len(diags) != len(tc.paths) || diags.HasError() {
+			t.Errorf("%s: expected %d warnings, got %v", name, len(tc.paths), diags)
+		}
+		for _, d := range diags {
+			if d.Severity != diag.Warning {
+				t.Errorf("%s: expected a warning, got %v", name, d)
+			}
+		}
+	}
+}
+
+func TestFindUnparsedObjectsRawValue(t *testing.T) {
+	var dashboard datadogV1.Dashboard
+	if err := json.Unmarshal([]byte(`{"title": "t", "layout_type": "ordered", "widgets": [{"definition": {"type": "new_widget", "foo": "bar"}}]}`), &dashboard); err != nil {
+		t.Fatal(err)
+	}
+	unparsed := FindUnparsedObjects(dashboard)
+	if len(unparsed) != 1 {
+		t.Fatalf("expected 1 unparsed object, got %v", unparsed)
+	}
+	raw, err := json.Marshal(unparsed[0].Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(raw) != `{"foo":"bar","type":"new_widget"}` {
+		t.Errorf("unexpected raw value %s", raw)
+	}
+}
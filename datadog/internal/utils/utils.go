@@ -2,11 +2,13 @@ package utils
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
@@ -26,6 +28,31 @@ type Resource interface {
 	GetOk(string) (interface{}, bool)
 }
 
+// authContext carries the cancellation and deadline of a request context and falls back
+// to the provider context for values such as the API keys and server variables
+type authContext struct {
+	context.Context
+	auth context.Context
+}
+
+func (c authContext) Value(key interface{}) interface{} {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+	return c.auth.Value(key)
+}
+
+// NewAuthContext merges the values of auth into ctx
+func NewAuthContext(ctx context.Context, auth context.Context) context.Context {
+	if ctx == nil {
+		return auth
+	}
+	if auth == nil {
+		return ctx
+	}
+	return authContext{Context: ctx, auth: auth}
+}
+
 // TranslateClientError turns an error into a message
 func TranslateClientError(err error, httpresp *http.Response, msg string) error {
 	if msg == "" {
@@ -49,7 +76,8 @@ func TranslateClientError(err error, httpresp *http.Response, msg string) error
 	return fmt.Errorf(msg+": %s", err.Error())
 }
 
-// CheckForUnparsed takes in a API response object and returns an error if it contains an unparsed element
+// CheckForUnparsed takes in a API response object and returns an error if it contains an unparsed element. Resources
+// building their payload from the configuration must fail on unparsed elements, since an update would drop them.
 func CheckForUnparsed(resp interface{}) error {
 	if unparsed, invalidPart := datadog.ContainsUnparsedObject(resp); unparsed {
 		return fmt.Errorf("object contains unparsed element: %+v", invalidPart)
@@ -134,6 +162,19 @@ func AccountNameAndChannelNameFromID(id string) (string, string, error) {
 	return result[0], result[1], nil
 }
 
+// DashboardListAndDashboardFromID returns dashboard list and dashboard from an ID
+func DashboardListAndDashboardFromID(id string) (int64, string, error) {
+	result := strings.SplitN(id, ":", 2)
+	if len(result) != 2 || result[1] == "" {
+		return 0, "", fmt.Errorf("error extracting dashboard list ID and dashboard ID: %s", id)
+	}
+	listID, err := strconv.ParseInt(result[0], 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("error extracting dashboard list ID and dashboard ID: %s", id)
+	}
+	return listID, result[1], nil
+}
+
 // ConvertResponseByteToMap converts JSON []byte to map[string]interface{}
 func ConvertResponseByteToMap(b []byte) (map[string]interface{}, error) {
 	convertedMap := make(map[string]interface{})
@@ -1,9 +1,12 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
 )
 
 func TestAccountAndLambdaArnFromID(t *testing.T) {
@@ -98,6 +101,38 @@ func TestAccountNameAndChannelNameFromID(t *testing.T) {
 	}
 }
 
+func TestDashboardListAndDashboardFromID(t *testing.T) {
+	cases := map[string]struct {
+		id          string
+		listID      int64
+		dashboardID string
+		err         error
+	}{
+		"basic":          {"1234:abc-def-ghi", 1234, "abc-def-ghi", nil},
+		"no delimeter":   {"1234", 0, "", fmt.Errorf("error extracting dashboard list ID and dashboard ID: 1234")},
+		"no dashboard":   {"1234:", 0, "", fmt.Errorf("error extracting dashboard list ID and dashboard ID: 1234:")},
+		"non-numeric ID": {"abc:abc-def-ghi", 0, "", fmt.Errorf("error extracting dashboard list ID and dashboard ID: abc:abc-def-ghi")},
+	}
+	for name, tc := range cases {
+		listID, dashboardID, err := DashboardListAndDashboardFromID(tc.id)
+
+		if err != nil && tc.err != nil && err.Error() != tc.err.Error() {
+			t.Errorf("%s: errors should be '%s', not `%s`", name, tc.err.Error(), err.Error())
+		} else if err != nil && tc.err == nil {
+			t.Errorf("%s: errors should be nil, not `%s`", name, err.Error())
+		} else if err == nil && tc.err != nil {
+			t.Errorf("%s: errors should be '%s', not nil", name, tc.err.Error())
+		}
+
+		if listID != tc.listID {
+			t.Errorf("%s: dashboard list ID '%d' didn't match `%d`", name, listID, tc.listID)
+		}
+		if dashboardID != tc.dashboardID {
+			t.Errorf("%s: dashboard ID '%s' didn't match `%s`", name, dashboardID, tc.dashboardID)
+		}
+	}
+}
+
 func TestConvertResponseByteToMap(t *testing.T) {
 	cases := map[string]struct {
 		js     string
@@ -154,6 +189,33 @@ func TestGetStringSlice(t *testing.T) {
 	}
 }
 
+func TestNewAuthContext(t *testing.T) {
+	keys := map[string]datadog.APIKey{"apiKeyAuth": {Key: "foo"}}
+	auth := context.WithValue(context.Background(), datadog.ContextAPIKeys, keys)
+	auth = context.WithValue(auth, datadog.ContextServerIndex, 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ctx = context.WithValue(ctx, datadog.ContextServerIndex, 2)
+	merged := NewAuthContext(ctx, auth)
+
+	if v, ok := merged.Value(datadog.ContextAPIKeys).(map[string]datadog.APIKey); !ok || v["apiKeyAuth"].Key != "foo" {
+		t.Errorf("expected API keys to be merged from the provider context, got %v", merged.Value(datadog.ContextAPIKeys))
+	}
+	if v := merged.Value(datadog.ContextServerIndex); v != 2 {
+		t.Errorf("expected values of the request context to take precedence, got %v", v)
+	}
+
+	cancel()
+	select {
+	case <-merged.Done():
+	default:
+		t.Errorf("expected merged context to be cancelled along with the request context")
+	}
+	if merged.Err() != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", merged.Err())
+	}
+}
+
 func validJSON() string {
 	return `
 {
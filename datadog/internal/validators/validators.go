@@ -18,6 +18,11 @@ func ValidateFloatString(v interface{}, k string) (ws []string, errors []error)
 	return validation.StringMatch(regexp.MustCompile(`\d*(\.\d*)?`), "value must be a float")(v, k)
 }
 
+// ValidateAPIPath makes sure a string is the path of a Datadog API endpoint
+func ValidateAPIPath(v interface{}, k string) (ws []string, errors []error) {
+	return validation.StringMatch(regexp.MustCompile(`^/api/`), "value must be an API path starting with `/api/`")(v, k)
+}
+
 // EnumChecker type to get allowed enum values from validate func
 type EnumChecker struct{}
 
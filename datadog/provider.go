@@ -8,15 +8,17 @@ import (
 	"net/http"
 	"net/url"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadog"
-	"github.com/hashicorp/go-cleanhttp"
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	datadogCommunity "github.com/zorkian/go-datadog-api"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/transport"
@@ -101,6 +103,24 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.MultiEnvDefaultFunc(APPKeyEnvVars, nil),
 				Description: "(Required unless validate is false) Datadog APP key. This can also be set via the DD_APP_KEY environment variable.",
 			},
+			"credentials_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DD_CREDENTIALS_FILE", nil),
+				Description: "Path to a credentials file holding `api_key` and `app_key` per named profile, used for the keys not set through `api_key`, `app_key` or `credential_process`. Files with a `.yaml` or `.yml` extension are parsed as YAML, other files as INI with one `[profile]` section per profile. This can also be set via the DD_CREDENTIALS_FILE environment variable.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DD_PROFILE", "default"),
+				Description: "The profile to read from `credentials_file`. This can also be set via the DD_PROFILE environment variable. Defaults to `default`.",
+			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DD_CREDENTIAL_PROCESS", nil),
+				Description: "A command run through the shell that prints the keys as a JSON object with `api_key` and `app_key` fields on its standard output, e.g. to fetch them from a secret manager. Used for the keys not set through `api_key` or `app_key`. This can also be set via the DD_CREDENTIAL_PROCESS environment variable.",
+			},
 			"api_url": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -111,13 +131,13 @@ func Provider() *schema.Provider {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     true,
-				Description: "Enables validation of the provided API and APP keys during provider initialization. Default is true. When false, api_key and app_key won't be checked.",
+				Description: "Enables validation of the provided API and APP keys during provider initialization. Default is true. When false, api_key and app_key won't be checked. The validation also warns about the resource types that the APP key lacks the permissions to manage.",
 			},
 			"http_client_retry_enabled": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("DD_HTTP_CLIENT_RETRY_ENABLED", true),
-				Description: "Enables request retries on HTTP status codes 429 and 5xx. Defaults to `true`.",
+				Description: "Enables request retries on HTTP status codes 429 and 5xx, and on connection errors for idempotent requests. Retries can be tuned with `http_client_retry_policy`. Defaults to `true`.",
 			},
 			"http_client_retry_timeout": {
 				Type:        schema.TypeInt,
@@ -125,10 +145,136 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("DD_HTTP_CLIENT_RETRY_TIMEOUT", nil),
 				Description: "The HTTP request retry timeout period. Defaults to 60 seconds.",
 			},
+			"http_client_retry_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The HTTP request retry policy. Only used when `http_client_retry_enabled` is `true`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      4,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of attempts for a request, including the first one.",
+						},
+						"backoff_base": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The wait time in seconds before the first retry. It is doubled on every subsequent retry.",
+						},
+						"backoff_max": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum wait time in seconds between two attempts. Defaults to `http_client_retry_timeout`.",
+						},
+						"jitter": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether to wait for a random time between 0 and the computed back off between two attempts, to spread the retries of concurrent requests.",
+						},
+						"retryable_status_codes": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The HTTP status codes to retry. Defaults to 429 and 5xx. A 429 response is retried after the `X-RateLimit-Reset` period.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+					},
+				},
+			},
+			"http_client_max_requests_per_second": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DD_HTTP_CLIENT_MAX_REQUESTS_PER_SECOND", nil),
+				ValidateFunc: validation.FloatAtLeast(0),
+				Description:  "The maximum number of requests per second sent by the provider to the Datadog API. Requests are also throttled according to the `X-RateLimit-*` headers returned by the API. Defaults to `0` (unlimited).",
+			},
+			"http_client_max_concurrent_requests": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DD_HTTP_CLIENT_MAX_CONCURRENT_REQUESTS", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The maximum number of concurrent in-flight requests sent by the provider to the Datadog API. Defaults to `0` (unlimited).",
+			},
+			"http_client_proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("DD_HTTP_CLIENT_PROXY_URL", nil),
+				Description: "The URL of the proxy used for requests to the Datadog API, e.g. `http://proxy.example.com:3128`. Defaults to the HTTP_PROXY and HTTPS_PROXY environment variables. This can also be set via the DD_HTTP_CLIENT_PROXY_URL environment variable.",
+			},
+			"http_client_no_proxy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Hosts, domains and CIDR ranges that must be reached without proxy, with the same syntax as the NO_PROXY environment variable. Defaults to the NO_PROXY environment variable.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"http_client_ca_cert_files": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Paths to PEM bundles of certificate authorities to trust in addition to the system ones, e.g. for a TLS-intercepting proxy.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"http_client_cert_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"http_client_key_file"},
+				Description:  "Path to a PEM client certificate used for mutual TLS. Requires `http_client_key_file`.",
+			},
+			"http_client_key_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"http_client_cert_file"},
+				Description:  "Path to the PEM private key of `http_client_cert_file`.",
+			},
+			"http_client_request_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DD_HTTP_CLIENT_REQUEST_TIMEOUT", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The timeout in seconds of a single HTTP request, retries excluded. Defaults to `0` (no timeout other than `http_client_retry_timeout`).",
+			},
+			"http_client_idle_conn_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The time in seconds an idle connection to the Datadog API is kept open. Defaults to 90 seconds.",
+			},
+			"http_client_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("DD_HTTP_CLIENT_CACHE_TTL", nil),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Enables an in-memory cache of the API GET responses for the given number of seconds, shared by every resource and data source of the provider instance. Identical concurrent requests are only sent once, and any write to an API endpoint invalidates the cached responses of this endpoint. Useful when data sources such as `datadog_role` or `datadog_permissions` are read many times during a plan. Defaults to `0` (disabled).",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags added to every `datadog_dashboard`, `datadog_monitor`, `datadog_service_level_objective`, `datadog_synthetics_test` and `datadog_security_monitoring_rule` resource. A tag with the same key set on the resource takes precedence.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "A map of tags, e.g. `{ team = \"core\", env = \"prod\" }` for `team:core` and `env:prod`. Use an empty value for a tag without value.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
 			"datadog_api_key":                              resourceDatadogApiKey(),
+			"datadog_api_object":                           resourceDatadogApiObject(),
 			"datadog_application_key":                      resourceDatadogApplicationKey(),
 			"datadog_authn_mapping":                        resourceDatadogAuthnMapping(),
 			"datadog_child_organization":                   resourceDatadogChildOrganization(),
@@ -137,7 +283,9 @@ func Provider() *schema.Provider {
 			"datadog_dashboard":                            resourceDatadogDashboard(),
 			"datadog_dashboard_json":                       resourceDatadogDashboardJSON(),
 			"datadog_dashboard_list":                       resourceDatadogDashboardList(),
+			"datadog_dashboard_list_item":                  resourceDatadogDashboardListItem(),
 			"datadog_downtime":                             resourceDatadogDowntime(),
+			"datadog_downtime_schedule":                    resourceDatadogDowntimeSchedule(),
 			"datadog_integration_aws":                      resourceDatadogIntegrationAws(),
 			"datadog_integration_aws_tag_filter":           resourceDatadogIntegrationAwsTagFilter(),
 			"datadog_integration_aws_lambda_arn":           resourceDatadogIntegrationAwsLambdaArn(),
@@ -161,6 +309,8 @@ func Provider() *schema.Provider {
 			"datadog_monitor":                              resourceDatadogMonitor(),
 			"datadog_monitor_config_policy":                resourceDatadogMonitorConfigPolicy(),
 			"datadog_monitor_json":                         resourceDatadogMonitorJSON(),
+			"datadog_notebook":                             resourceDatadogNotebook(),
+			"datadog_notebook_json":                        resourceDatadogNotebookJSON(),
 			"datadog_organization_settings":                resourceDatadogOrganizationSettings(),
 			"datadog_role":                                 resourceDatadogRole(),
 			"datadog_rum_application":                      resourceDatadogRUMApplication(),
@@ -169,7 +319,9 @@ func Provider() *schema.Provider {
 			"datadog_security_monitoring_rule":             resourceDatadogSecurityMonitoringRule(),
 			"datadog_security_monitoring_filter":           resourceDatadogSecurityMonitoringFilter(),
 			"datadog_service_level_objective":              resourceDatadogServiceLevelObjective(),
+			"datadog_service_level_objective_alert":        resourceDatadogServiceLevelObjectiveAlert(),
 			"datadog_service_definition_yaml":              resourceDatadogServiceDefinitionYAML(),
+			"datadog_shared_dashboard":                     resourceDatadogSharedDashboard(),
 			"datadog_slo_correction":                       resourceDatadogSloCorrection(),
 			"datadog_synthetics_test":                      resourceDatadogSyntheticsTest(),
 			"datadog_synthetics_global_variable":           resourceDatadogSyntheticsGlobalVariable(),
@@ -181,10 +333,12 @@ func Provider() *schema.Provider {
 
 		DataSourcesMap: map[string]*schema.Resource{
 			"datadog_api_key":                             dataSourceDatadogApiKey(),
+			"datadog_api_request":                         dataSourceDatadogApiRequest(),
 			"datadog_application_key":                     dataSourceDatadogApplicationKey(),
 			"datadog_cloud_workload_security_agent_rules": dataSourceDatadogCloudWorkloadSecurityAgentRules(),
 			"datadog_dashboard":                           dataSourceDatadogDashboard(),
 			"datadog_dashboard_list":                      dataSourceDatadogDashboardList(),
+			"datadog_dashboard_widget":                    dataSourceDatadogDashboardWidget(),
 			"datadog_integration_aws_logs_services":       dataSourceDatadogIntegrationAWSLogsServices(),
 			"datadog_ip_ranges":                           dataSourceDatadogIPRanges(),
 			"datadog_logs_archives_order":                 dataSourceDatadogLogsArchivesOrder(),
@@ -201,6 +355,7 @@ func Provider() *schema.Provider {
 			"datadog_security_monitoring_rules":           dataSourceDatadogSecurityMonitoringRules(),
 			"datadog_security_monitoring_filters":         dataSourceDatadogSecurityMonitoringFilters(),
 			"datadog_service_level_objective":             dataSourceDatadogServiceLevelObjective(),
+			"datadog_service_level_objective_history":     dataSourceDatadogServiceLevelObjectiveHistory(),
 			"datadog_service_level_objectives":            dataSourceDatadogServiceLevelObjectives(),
 			"datadog_synthetics_locations":                dataSourceDatadogSyntheticsLocations(),
 			"datadog_synthetics_global_variable":          dataSourceDatadogSyntheticsGlobalVariable(),
@@ -218,21 +373,42 @@ func Provider() *schema.Provider {
 type ProviderConfiguration struct {
 	CommunityClient     *datadogCommunity.Client
 	DatadogApiInstances *utils.ApiInstances
-	Auth                context.Context
+	// Auth holds the API keys and server values, see AuthContext to make API calls
+	Auth context.Context
+	// DefaultTags holds the provider `default_tags`, as a sorted list of `key:value` tags
+	DefaultTags []string
 
 	Now func() time.Time
 }
 
+// AuthContext returns a context carrying the cancellation and deadline of ctx, along with the
+// authentication and server values of the provider. API calls must use it instead of Auth so that
+// interrupting Terraform, or reaching an operation timeout, stops in-flight requests.
+func (c *ProviderConfiguration) AuthContext(ctx context.Context) context.Context {
+	return utils.NewAuthContext(ctx, c.Auth)
+}
+
 func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	apiKey := d.Get("api_key").(string)
 	appKey := d.Get("app_key").(string)
 	validate := d.Get("validate").(bool)
 	httpRetryEnabled := d.Get("http_client_retry_enabled").(bool)
 
+	apiKey, appKey, err := loadExternalCredentials(ctx, d, apiKey, appKey)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	if validate && (apiKey == "" || appKey == "") {
 		return nil, diag.FromErr(errors.New("api_key and app_key must be set unless validate = false"))
 	}
 
+	// Both clients share the same networking configuration
+	httpTransport, err := transport.NewHTTPTransport(buildHTTPClientOptions(d))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
 	// Initialize the community client
 	communityClient := datadogCommunity.NewClient(apiKey, appKey)
 
@@ -240,8 +416,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 		communityClient.SetBaseUrl(apiURL)
 	}
 
-	c := cleanhttp.DefaultClient()
-	c.Transport = logging.NewTransport("Datadog", c.Transport)
+	c := &http.Client{Transport: logging.NewTransport("Datadog", httpTransport)}
 	communityClient.ExtraHeader["User-Agent"] = utils.GetUserAgent(fmt.Sprintf(
 		"datadog-api-client-go/%s (go %s; os %s; arch %s)",
 		"go-datadog-api",
@@ -251,32 +426,33 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	))
 	communityClient.HttpClient = c
 
-	if validate {
-		log.Println("[INFO] Datadog client successfully initialized, now validating...")
-		ok, err := communityClient.Validate()
-		if err != nil {
-			log.Printf("[ERROR] Datadog Client validation error: %v", err)
-			return nil, diag.FromErr(err)
-		} else if !ok {
-			err := errors.New(`Invalid or missing credentials provided to the Datadog Provider. Please confirm your API and APP keys are valid and are for the correct region, see https://www.terraform.io/docs/providers/datadog/ for more information on providing credentials for the Datadog Provider`)
-			log.Printf("[ERROR] Datadog Client validation error: %v", err)
-			return nil, diag.FromErr(err)
-		}
-	} else {
-		log.Println("[INFO] Skipping key validation (validate = false)")
+	// Initialize http.Client for the Datadog API Clients.
+	// Each provider instance gets its own client so that rate limits are tracked per instance.
+	// The client is also used by utils.SendRequest through the API client configuration.
+	httpClient := &http.Client{Transport: httpTransport}
+	rateLimitOptions := transport.RateLimiterOptions{}
+	if v, ok := d.GetOk("http_client_max_requests_per_second"); ok {
+		rateLimitOptions.MaxRequestsPerSecond = v.(float64)
+	}
+	if v, ok := d.GetOk("http_client_max_concurrent_requests"); ok {
+		rateLimitOptions.MaxConcurrentRequests = v.(int)
 	}
-	log.Printf("[INFO] Datadog Client successfully validated.")
-
-	// Initialize http.Client for the Datadog API Clients
-	httpClient := http.DefaultClient
 	if httpRetryEnabled {
-		ctOptions := transport.CustomTransportOptions{}
+		ctOptions := transport.CustomTransportOptions{
+			RetryPolicy: buildRetryPolicy(d),
+			RateLimit:   rateLimitOptions,
+		}
 		if v, ok := d.GetOk("http_client_retry_timeout"); ok {
 			timeout := time.Duration(int64(v.(int))) * time.Second
 			ctOptions.Timeout = &timeout
 		}
 		customTransport := transport.NewCustomTransport(httpClient.Transport, ctOptions)
 		httpClient.Transport = customTransport
+	} else {
+		httpClient.Transport = transport.NewRateLimitTransport(httpClient.Transport, rateLimitOptions)
+	}
+	if v, ok := d.GetOk("http_client_cache_ttl"); ok {
+		httpClient.Transport = transport.NewCacheTransport(httpClient.Transport, time.Duration(v.(int))*time.Second)
 	}
 
 	// Initialize the official Datadog V1 API client
@@ -332,12 +508,246 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}
 	}
 
 	datadogClient := datadog.NewAPIClient(config)
+	apiInstances := &utils.ApiInstances{HttpClient: datadogClient}
+
+	var diags diag.Diagnostics
+	if validate {
+		log.Println("[INFO] Datadog client successfully initialized, now validating...")
+		diags = validateCredentials(apiInstances, utils.NewAuthContext(ctx, auth), appKey)
+		if diags.HasError() {
+			return nil, diags
+		}
+		log.Printf("[INFO] Datadog Client successfully validated.")
+	} else {
+		log.Println("[INFO] Skipping key validation (validate = false)")
+	}
 
 	return &ProviderConfiguration{
 		CommunityClient:     communityClient,
-		DatadogApiInstances: &utils.ApiInstances{HttpClient: datadogClient},
+		DatadogApiInstances: apiInstances,
 		Auth:                auth,
+		DefaultTags:         buildDefaultTags(d),
 
 		Now: time.Now,
-	}, nil
+	}, diags
+}
+
+// validateCredentials checks that the API and APP keys are valid through the official client, then warns about the resource types
+// that can't be managed with the permissions of the APP key
+func validateCredentials(apiInstances *utils.ApiInstances, auth context.Context, appKey string) diag.Diagnostics {
+	invalidCredentials := `Invalid or missing credentials provided to the Datadog Provider. Please confirm your API and APP keys are valid and are for the correct region, see https://www.terraform.io/docs/providers/datadog/ for more information on providing credentials for the Datadog Provider`
+
+	if _, httpresp, err := apiInstances.GetAuthenticationApiV1().Validate(auth); err != nil {
+		log.Printf("[ERROR] Datadog Client validation error: %v", err)
+		if httpresp != nil && httpresp.StatusCode == 403 {
+			return diag.FromErr(errors.New(invalidCredentials))
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error validating API key")
+	}
+
+	permissionsWarning := func(detail string) diag.Diagnostics {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unable to check the permissions of the APP key",
+			Detail:   detail,
+		}}
+	}
+
+	// The APP key is only known from its last characters among the keys of the current user
+	var matches []datadogV2.PartialApplicationKey
+	pageSize := int64(100)
+	optionalParams := datadogV2.NewListCurrentUserApplicationKeysOptionalParameters().WithPageSize(pageSize)
+	for page := int64(0); ; page++ {
+		keys, httpresp, err := apiInstances.GetKeyManagementApiV2().ListCurrentUserApplicationKeys(auth, *optionalParams.WithPageNumber(page))
+		if err != nil {
+			log.Printf("[ERROR] Datadog Client validation error: %v", err)
+			if httpresp != nil && httpresp.StatusCode == 403 {
+				// Scoped APP keys may not be allowed to read application keys
+				return permissionsWarning("The APP key is not allowed to list the application keys of its owner.")
+			}
+			return utils.TranslateClientErrorDiag(err, httpresp, "error validating APP key")
+		}
+		for _, k := range keys.GetData() {
+			if attributes := k.GetAttributes(); len(appKey) >= 4 && attributes.GetLast4() == appKey[len(appKey)-4:] {
+				matches = append(matches, k)
+			}
+		}
+		if int64(len(keys.GetData())) < pageSize {
+			break
+		}
+	}
+	if len(matches) == 0 {
+		return permissionsWarning("The APP key was not found among the keys of its owner.")
+	}
+	if len(matches) > 1 {
+		return permissionsWarning("Several keys of the owner of the APP key end with the same characters, the APP key can't be told apart from them.")
+	}
+	key := matches[0]
+
+	relationships := key.GetRelationships()
+	ownedBy := relationships.GetOwnedBy()
+	owner := ownedBy.GetData()
+	permissions, httpresp, err := apiInstances.GetUsersApiV2().ListUserPermissions(auth, owner.GetId())
+	if err != nil {
+		return permissionsWarning(utils.TranslateClientError(err, httpresp, "error listing the permissions of the APP key owner").Error())
+	}
+
+	var ownerPermissions []string
+	for _, permission := range permissions.GetData() {
+		attributes := permission.GetAttributes()
+		ownerPermissions = append(ownerPermissions, attributes.GetName())
+	}
+	attributes := key.GetAttributes()
+	unmanageable := utils.UnmanageableResourceTypes(utils.EffectivePermissions(ownerPermissions, attributes.GetScopes()))
+	if len(unmanageable) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Missing permissions for some resource types",
+		Detail: fmt.Sprintf("The APP key, through the roles of its owner or its scopes, lacks the permissions to manage the following resource types:\n  - %s",
+			strings.Join(unmanageable, "\n  - ")),
+	}}
+}
+
+// loadExternalCredentials fills the keys missing from the provider configuration with the ones
+// returned by `credential_process`, then with the ones of the `credentials_file` profile
+func loadExternalCredentials(ctx context.Context, d *schema.ResourceData, apiKey, appKey string) (string, string, error) {
+	if command, ok := d.GetOk("credential_process"); ok && (apiKey == "" || appKey == "") {
+		log.Println("[INFO] Loading Datadog keys from credential_process")
+		credentials, err := utils.RunCredentialProcess(ctx, command.(string))
+		if err != nil {
+			return "", "", err
+		}
+		if apiKey == "" {
+			apiKey = credentials.APIKey
+		}
+		if appKey == "" {
+			appKey = credentials.APPKey
+		}
+	}
+
+	if path, ok := d.GetOk("credentials_file"); ok && (apiKey == "" || appKey == "") {
+		profile := d.Get("profile").(string)
+		log.Printf("[INFO] Loading Datadog keys from profile %s of %s", profile, path)
+		credentials, err := utils.LoadCredentialsFile(path.(string), profile)
+		if err != nil {
+			return "", "", err
+		}
+		if apiKey == "" {
+			apiKey = credentials.APIKey
+		}
+		if appKey == "" {
+			appKey = credentials.APPKey
+		}
+	}
+
+	return apiKey, appKey, nil
+}
+
+func buildHTTPClientOptions(d *schema.ResourceData) transport.HTTPClientOptions {
+	opt := transport.HTTPClientOptions{
+		ProxyURL:        d.Get("http_client_proxy_url").(string),
+		ClientCertFile:  d.Get("http_client_cert_file").(string),
+		ClientKeyFile:   d.Get("http_client_key_file").(string),
+		RequestTimeout:  time.Duration(d.Get("http_client_request_timeout").(int)) * time.Second,
+		IdleConnTimeout: time.Duration(d.Get("http_client_idle_conn_timeout").(int)) * time.Second,
+	}
+	for _, host := range d.Get("http_client_no_proxy").([]interface{}) {
+		opt.NoProxy = append(opt.NoProxy, host.(string))
+	}
+	for _, file := range d.Get("http_client_ca_cert_files").([]interface{}) {
+		opt.CACertFiles = append(opt.CACertFiles, file.(string))
+	}
+	return opt
+}
+
+func buildRetryPolicy(d *schema.ResourceData) transport.RetryPolicy {
+	// Defaults are set by the transport when the block is omitted
+	policy := transport.RetryPolicy{Jitter: true}
+	if _, ok := d.GetOk("http_client_retry_policy"); !ok {
+		return policy
+	}
+
+	policy.MaxAttempts = d.Get("http_client_retry_policy.0.max_attempts").(int)
+	policy.BackOffBase = time.Duration(d.Get("http_client_retry_policy.0.backoff_base").(int)) * time.Second
+	policy.BackOffMax = time.Duration(d.Get("http_client_retry_policy.0.backoff_max").(int)) * time.Second
+	policy.Jitter = d.Get("http_client_retry_policy.0.jitter").(bool)
+	for _, code := range d.Get("http_client_retry_policy.0.retryable_status_codes").([]interface{}) {
+		policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+	}
+	return policy
+}
+
+func buildDefaultTags(d *schema.ResourceData) []string {
+	var tags []string
+	for k, v := range d.Get("default_tags.0.tags").(map[string]interface{}) {
+		if value := v.(string); value != "" {
+			tags = append(tags, k+":"+value)
+		} else {
+			tags = append(tags, k)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// getTagsAllSchema returns the schema of the `tags_all` attribute of resources supporting the provider `default_tags`
+func getTagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Computed:    true,
+		Description: "All the tags of the resource, including the ones inherited from the provider `default_tags`.",
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// mergeDefaultTags returns the tags to send to the API, i.e. the resource tags and the provider `default_tags`
+func mergeDefaultTags(meta interface{}, tags []string) []string {
+	return utils.MergeDefaultTags(meta.(*ProviderConfiguration).DefaultTags, tags)
+}
+
+// getConfiguredTags returns the `tags` of the resource, whether it is a list or a set
+func getConfiguredTags(tfTags interface{}) []string {
+	if set, ok := tfTags.(*schema.Set); ok {
+		tfTags = set.List()
+	}
+	var tags []string
+	for _, tag := range tfTags.([]interface{}) {
+		tags = append(tags, tag.(string))
+	}
+	return tags
+}
+
+// setTagsState sets `tags_all` to the tags returned by the API and `tags` to the same tags minus
+// the ones inherited from the provider `default_tags`, so that they don't show up as a diff
+func setTagsState(d *schema.ResourceData, meta interface{}, tags []string) error {
+	defaultTags := meta.(*ProviderConfiguration).DefaultTags
+	configuredTags := getConfiguredTags(d.Get("tags"))
+	if err := d.Set("tags", utils.RemoveDefaultTags(defaultTags, configuredTags, tags)); err != nil {
+		return err
+	}
+	return d.Set("tags_all", tags)
+}
+
+// customizeDiffTagsAll plans `tags_all` from the configured `tags` and the provider `default_tags`
+func customizeDiffTagsAll(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("tags") {
+		return diff.SetNewComputed("tags_all")
+	}
+	tagsAll := mergeDefaultTags(meta, getConfiguredTags(diff.Get("tags")))
+
+	// Only plan a change when the tags actually differ, the API may return them normalized
+	current := make(map[string]bool)
+	for _, tag := range getConfiguredTags(diff.Get("tags_all")) {
+		current[utils.NormalizeTag(tag)] = true
+	}
+	changed := len(current) != len(tagsAll)
+	for _, tag := range tagsAll {
+		changed = changed || !current[utils.NormalizeTag(tag)]
+	}
+	if !changed {
+		return nil
+	}
+	return diff.SetNew("tags_all", tagsAll)
 }
@@ -68,7 +68,7 @@ func updateApiKeyState(d *schema.ResourceData, apiKeyData *datadogV2.FullAPIKey)
 func resourceDatadogApiKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetKeyManagementApiV2().CreateAPIKey(auth, *buildDatadogApiKeyCreateV2Struct(d))
 	if err != nil {
@@ -84,7 +84,7 @@ func resourceDatadogApiKeyCreate(ctx context.Context, d *schema.ResourceData, me
 func resourceDatadogApiKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetKeyManagementApiV2().GetAPIKey(auth, d.Id())
 	if err != nil {
@@ -101,7 +101,7 @@ func resourceDatadogApiKeyRead(ctx context.Context, d *schema.ResourceData, meta
 func resourceDatadogApiKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetKeyManagementApiV2().UpdateAPIKey(auth, d.Id(), *buildDatadogApiKeyUpdateV2Struct(d))
 	if err != nil {
@@ -114,7 +114,7 @@ func resourceDatadogApiKeyUpdate(ctx context.Context, d *schema.ResourceData, me
 func resourceDatadogApiKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetKeyManagementApiV2().DeleteAPIKey(auth, d.Id()); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error deleting api key")
@@ -0,0 +1,237 @@
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceDatadogApiObject() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a generic Datadog API object resource. This can be used to manage objects of Datadog API endpoints that don't have a dedicated resource yet, by sending their JSON definition to configurable endpoints. Paths can reference the ID of the object with `{id}`.",
+		CreateContext: resourceDatadogApiObjectCreate,
+		ReadContext:   resourceDatadogApiObjectRead,
+		UpdateContext: resourceDatadogApiObjectUpdate,
+		DeleteContext: resourceDatadogApiObjectDelete,
+		Schema: map[string]*schema.Schema{
+			"create_path": {
+				Description:  "The path of the API endpoint used to create the object, e.g. `/api/v2/roles`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validators.ValidateAPIPath,
+			},
+			"create_method": {
+				Description:      "The HTTP method used to create the object.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "POST",
+				ValidateDiagFunc: validators.ValidateStringEnumValue("POST", "PUT", "PATCH"),
+			},
+			"read_path": {
+				Description:  "The path of the API endpoint used to read the object. Defaults to `<create_path>/{id}`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validators.ValidateAPIPath,
+			},
+			"update_path": {
+				Description:  "The path of the API endpoint used to update the object. Defaults to `read_path`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validators.ValidateAPIPath,
+			},
+			"update_method": {
+				Description:      "The HTTP method used to update the object.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "PUT",
+				ValidateDiagFunc: validators.ValidateStringEnumValue("PUT", "PATCH", "POST"),
+			},
+			"delete_path": {
+				Description:  "The path of the API endpoint used to delete the object. Defaults to `read_path`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validators.ValidateAPIPath,
+			},
+			"delete_method": {
+				Description:      "The HTTP method used to delete the object.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "DELETE",
+				ValidateDiagFunc: validators.ValidateStringEnumValue("DELETE", "POST"),
+			},
+			"id_path": {
+				Description: "The JSON pointer to the ID of the object in the create response, e.g. `/data/id` for v2 endpoints.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "/id",
+			},
+			"ignore_paths": {
+				Description: "JSON pointers to the fields of the read response that are not part of `body`, such as computed fields. They are ignored when checking for differences, e.g. `/data/id` or `/data/attributes/created_at`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"body": {
+				Description:      "The JSON formatted definition of the object, sent to the create and update endpoints.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: diffSuppressApiObjectBody,
+			},
+			// Computed values
+			"response_body": {
+				Description: "The JSON body of the last read response, ignored paths included. Use `jsondecode` to access its fields.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// diffSuppressApiObjectBody compares both bodies once the ignored paths are removed
+func diffSuppressApiObjectBody(k, old, new string, d *schema.ResourceData) bool {
+	ignorePaths := utils.GetStringSlice(d, "ignore_paths")
+	oldBody, err := normalizeApiObjectBody(old, ignorePaths)
+	if err != nil {
+		return false
+	}
+	newBody, err := normalizeApiObjectBody(new, ignorePaths)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldBody, newBody)
+}
+
+func normalizeApiObjectBody(body string, ignorePaths []string) (interface{}, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(body), &document); err != nil {
+		return nil, err
+	}
+	for _, p := range ignorePaths {
+		if err := utils.DeleteJSONPointer(document, p); err != nil {
+			return nil, err
+		}
+	}
+	return document, nil
+}
+
+// getApiObjectPath returns the path used for the given operation, with the object ID substituted
+func getApiObjectPath(d *schema.ResourceData, operation string) string {
+	path := d.Get("read_path").(string)
+	if path == "" {
+		path = strings.TrimSuffix(d.Get("create_path").(string), "/") + "/{id}"
+	}
+	if operation != "read" {
+		if p := d.Get(operation + "_path").(string); p != "" {
+			path = p
+		}
+	}
+	return strings.ReplaceAll(path, "{id}", url.PathEscape(d.Id()))
+}
+
+func resourceDatadogApiObjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	body := d.Get("body").(string)
+	path := d.Get("create_path").(string)
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, d.Get("create_method").(string), path, &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error creating object")
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(respByte, &document); err != nil {
+		return diag.FromErr(err)
+	}
+	id, err := utils.GetJSONPointer(document, d.Get("id_path").(string))
+	if err != nil {
+		return diag.Errorf("error retrieving id from response: %v", err)
+	}
+	switch id := id.(type) {
+	case string:
+		d.SetId(id)
+	case float64:
+		d.SetId(strconv.FormatFloat(id, 'f', -1, 64))
+	default:
+		return diag.Errorf("error retrieving id from response: unexpected value %v at %s", id, d.Get("id_path"))
+	}
+
+	return resourceDatadogApiObjectRead(ctx, d, meta)
+}
+
+func resourceDatadogApiObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	respByte, httpResp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", getApiObjectPath(d, "read"), nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpResp, "error getting object")
+	}
+
+	if err := d.Set("response_body", string(respByte)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	document, err := normalizeApiObjectBody(string(respByte), utils.GetStringSlice(d, "ignore_paths"))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error parsing response: %w", err))
+	}
+	body, err := json.Marshal(document)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("body", string(body)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceDatadogApiObjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	body := d.Get("body").(string)
+	_, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, d.Get("update_method").(string), getApiObjectPath(d, "update"), &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error updating object")
+	}
+
+	return resourceDatadogApiObjectRead(ctx, d, meta)
+}
+
+func resourceDatadogApiObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	_, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, d.Get("delete_method").(string), getApiObjectPath(d, "delete"), nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error deleting object")
+	}
+
+	return nil
+}
@@ -46,7 +46,7 @@ func resourceDatadogAuthnMapping() *schema.Resource {
 
 func resourceDatadogAuthnMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 	authNMapReq := buildAuthNMappingCreateRequest(d)
 
 	createResp, httpResponse, err := apiInstances.GetAuthNMappingsApiV2().CreateAuthNMapping(auth, authNMapReq)
@@ -86,7 +86,7 @@ func resourceDatadogAuthnMappingCreate(ctx context.Context, d *schema.ResourceDa
 
 func resourceDatadogAuthnMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetAuthNMappingsApiV2().GetAuthNMapping(auth, d.Id())
 	if err != nil {
@@ -102,7 +102,7 @@ func resourceDatadogAuthnMappingRead(ctx context.Context, d *schema.ResourceData
 
 func resourceDatadogAuthnMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	req := buildAuthNMappingUpdateRequest(d)
 	resp, httpResponse, err := apiInstances.GetAuthNMappingsApiV2().UpdateAuthNMapping(auth, d.Id(), req)
@@ -121,7 +121,7 @@ func resourceDatadogAuthnMappingUpdate(ctx context.Context, d *schema.ResourceDa
 
 func resourceDatadogAuthnMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	httpResponse, err := apiInstances.GetAuthNMappingsApiV2().DeleteAuthNMapping(auth, d.Id())
 	if err != nil {
@@ -319,7 +319,7 @@ func updateOrganizationUserState(d *schema.ResourceData, apiKey *datadogV1.User)
 func resourceDatadogChildOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetOrganizationsApiV1().CreateChildOrg(auth, *buildDatadogOrganizationCreateV1Struct(d))
 	if err != nil {
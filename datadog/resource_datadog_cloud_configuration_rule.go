@@ -103,7 +103,7 @@ func cloudConfigurationRuleSchema() map[string]*schema.Schema {
 func cloudConfigurationRuleCreateContext(ctx context.Context, d *schema.ResourceData, metadata interface{}) diag.Diagnostics {
 	providerConf := metadata.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ruleCreate := buildRuleCreatePayload(d)
 
@@ -165,7 +165,7 @@ func buildComplianceSignalOptions(d *schema.ResourceData) datadogV2.CloudConfigu
 func cloudConfigurationRuleUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ruleUpdate := buildRuleUpdatePayload(d)
 	response, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().UpdateSecurityMonitoringRule(auth, d.Id(), ruleUpdate)
@@ -236,7 +236,7 @@ func buildRuleUpdateCases(d *schema.ResourceData) []datadogV2.SecurityMonitoring
 func cloudConfigurationRuleReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 	ruleResponse, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().GetSecurityMonitoringRule(auth, id)
@@ -2,9 +2,12 @@ package datadog
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
+	"reflect"
+	"sort"
+	"strings"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
 	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
@@ -13,36 +16,42 @@ import (
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceDatadogDashboard() *schema.Resource {
 	return &schema.Resource{
-		Description:   "Provides a Datadog dashboard resource. This can be used to create and manage Datadog dashboards.",
+		Description:   "Provides a Datadog dashboard resource. This can be used to create and manage Datadog dashboards. Widget elements that this version of the provider doesn't support are reported as warnings and kept as raw JSON on updates, other resources fail on the elements they don't support.",
 		CreateContext: resourceDatadogDashboardCreate,
 		UpdateContext: resourceDatadogDashboardUpdate,
 		ReadContext:   resourceDatadogDashboardRead,
 		DeleteContext: resourceDatadogDashboardDelete,
-		CustomizeDiff: func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
-			oldValue, newValue := diff.GetChange("dashboard_lists")
-			if !oldValue.(*schema.Set).Equal(newValue.(*schema.Set)) {
-				// Only calculate removed when the list change, to no create useless diffs
-				removed := oldValue.(*schema.Set).Difference(newValue.(*schema.Set))
-				if err := diff.SetNew("dashboard_lists_removed", removed); err != nil {
-					return err
-				}
-			} else {
-				if err := diff.Clear("dashboard_lists_removed"); err != nil {
-					return err
+		CustomizeDiff: customdiff.All(
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				oldValue, newValue := diff.GetChange("dashboard_lists")
+				if !oldValue.(*schema.Set).Equal(newValue.(*schema.Set)) {
+					// Only calculate removed when the list change, to no create useless diffs
+					removed := oldValue.(*schema.Set).Difference(newValue.(*schema.Set))
+					if err := diff.SetNew("dashboard_lists_removed", removed); err != nil {
+						return err
+					}
+				} else {
+					if err := diff.Clear("dashboard_lists_removed"); err != nil {
+						return err
+					}
 				}
-			}
 
-			return nil
-		},
+				return nil
+			},
+			validateDashboardLayoutDiff,
+			customizeDiffTagsAll,
+		),
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDatadogDashboardImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"title": {
@@ -58,6 +67,16 @@ func resourceDatadogDashboard() *schema.Resource {
 					Schema: getWidgetSchema(),
 				},
 			},
+			"widget_json": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The list of JSON formatted widgets to display on the dashboard after the widgets of `widget`, e.g. the `json` of `datadog_dashboard_widget` data sources. The state assumes the last widgets of the dashboard are the ones of `widget_json`, as many as there are `widget_json`, so widgets moved or added after them outside of Terraform are read in the wrong attribute.",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsJSON,
+					StateFunc:    normalizeWidgetJSON,
+				},
+			},
 			"layout_type": {
 				Type:             schema.TypeString,
 				Required:         true,
@@ -71,6 +90,18 @@ func resourceDatadogDashboard() *schema.Resource {
 				Description:      "The reflow type of a new dashboard layout. Set this only when layout type is `ordered`. If set to `fixed`, the dashboard expects all widgets to have a layout, and if it's set to `auto`, widgets should not have layouts.",
 				ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewDashboardReflowTypeFromValue),
 			},
+			"auto_layout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to place the widgets without `widget_layout`, in order, in the first space they fit in from top to bottom and left to right. Only for `free` dashboards and for `ordered` dashboards with a `fixed` reflow type. The widgets are 4 columns wide and 2 rows high on `ordered` dashboards, group widgets are 12 columns wide and as high as their widgets. They're 32 by 16 on `free` dashboards, 4 per row. The computed layouts aren't stored in the state, they're computed again on each apply.",
+			},
+			"allow_overlapping_widgets": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the `widget_layout` of widgets can overlap, e.g. to stack widgets. Only for `free` dashboards, the widgets of other dashboards can't overlap.",
+			},
 			"description": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -117,6 +148,13 @@ func resourceDatadogDashboard() *schema.Resource {
 				Description: "The list of handles for the users to notify when changes are made to this dashboard.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "A list of tags assigned to the dashboard. Only team names of the form `team:<name>` are supported by the API.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": getTagsAllSchema(),
 			"dashboard_lists": {
 				Type:        schema.TypeSet,
 				Optional:    true,
@@ -144,18 +182,18 @@ func resourceDatadogDashboard() *schema.Resource {
 func resourceDatadogDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	dashboardPayload, err := buildDatadogDashboard(d)
 	if err != nil {
 		return diag.Errorf("failed to parse resource configuration: %s", err.Error())
 	}
+	if tags := mergeDefaultTags(meta, getConfiguredTags(d.Get("tags"))); len(tags) > 0 {
+		dashboardPayload.AdditionalProperties = map[string]interface{}{"tags": tags}
+	}
 	dashboard, httpresp, err := apiInstances.GetDashboardsApiV1().CreateDashboard(auth, *dashboardPayload)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error creating dashboard")
 	}
-	if err := utils.CheckForUnparsed(dashboard); err != nil {
-		return diag.FromErr(err)
-	}
 	d.SetId(*dashboard.Id)
 
 	var getDashboard datadogV1.Dashboard
@@ -169,62 +207,85 @@ func resourceDatadogDashboardCreate(ctx context.Context, d *schema.ResourceData,
 
 			return resource.NonRetryableError(err)
 		}
-		if err := utils.CheckForUnparsed(getDashboard); err != nil {
-			return resource.NonRetryableError(err)
-		}
-
-		// We only log the error, as failing to update the list shouldn't fail dashboard creation
-		updateDashboardLists(d, providerConf, *dashboard.Id, d.Get("layout_type").(string))
 
 		return nil
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := parseDashboardTags(&getDashboard, httpResponse); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Failing to update the lists only warns, as the dashboard would otherwise be tainted. The lists it
+	// doesn't belong to are removed from the state, so they're added again on the next apply
+	diags := warningDiags(updateDashboardLists(ctx, d, providerConf, *dashboard.Id, d.Get("layout_type").(string)))
 
-	return updateDashboardState(d, &getDashboard)
+	stateDiags := updateDashboardState(d, meta, &getDashboard)
+	diags = append(diags, stateDiags...)
+	if stateDiags.HasError() {
+		return diags
+	}
+	return append(diags, updateDashboardListsState(ctx, d, providerConf, *dashboard.Id)...)
 }
 
 func resourceDatadogDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	id := d.Id()
 	dashboard, err := buildDatadogDashboard(d)
 	if err != nil {
 		return diag.Errorf("failed to parse resource configuration: %s", err.Error())
 	}
+	// The removed tags are only cleared when sending an empty list
+	tags := mergeDefaultTags(meta, getConfiguredTags(d.Get("tags")))
+	if oldTags, _ := d.GetChange("tags_all"); len(tags) > 0 || oldTags.(*schema.Set).Len() > 0 {
+		dashboard.AdditionalProperties = map[string]interface{}{"tags": append([]string{}, tags...)}
+	}
 	updatedDashboard, httpresp, err := apiInstances.GetDashboardsApiV1().UpdateDashboard(auth, id, *dashboard)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error updating dashboard")
 	}
-	if err := utils.CheckForUnparsed(updatedDashboard); err != nil {
+	if err := parseDashboardTags(&updatedDashboard, httpresp); err != nil {
 		return diag.FromErr(err)
 	}
 
-	updateDashboardLists(d, providerConf, *dashboard.Id, d.Get("layout_type").(string))
+	// The lists the dashboard doesn't belong to are removed from the state even when updating them fails
+	diags := updateDashboardLists(ctx, d, providerConf, *dashboard.Id, d.Get("layout_type").(string))
 
-	return updateDashboardState(d, &updatedDashboard)
+	stateDiags := updateDashboardState(d, meta, &updatedDashboard)
+	diags = append(diags, stateDiags...)
+	if stateDiags.HasError() {
+		return diags
+	}
+	return append(diags, updateDashboardListsState(ctx, d, providerConf, id)...)
 }
 
-func updateDashboardLists(d *schema.ResourceData, providerConf *ProviderConfiguration, dashboardID string, layoutType string) {
-	dashTypeString := "custom_screenboard"
-	if layoutType == "ordered" {
-		dashTypeString = "custom_timeboard"
+// dashboardListItemType returns the type of a custom dashboard in dashboard lists
+func dashboardListItemType(layoutType string) datadogV2.DashboardType {
+	if layoutType == string(datadogV1.DASHBOARDLAYOUTTYPE_ORDERED) {
+		return datadogV2.DASHBOARDTYPE_CUSTOM_TIMEBOARD
 	}
-	dashType := datadogV2.DashboardType(dashTypeString)
-	itemsRequest := []datadogV2.DashboardListItemRequest{*datadogV2.NewDashboardListItemRequest(dashboardID, dashType)}
+	return datadogV2.DASHBOARDTYPE_CUSTOM_SCREENBOARD
+}
+
+// updateDashboardLists adds the dashboard to the lists of `dashboard_lists` and removes it from the lists of
+// `dashboard_lists_removed`
+func updateDashboardLists(ctx context.Context, d *schema.ResourceData, providerConf *ProviderConfiguration, dashboardID string, layoutType string) diag.Diagnostics {
+	itemsRequest := []datadogV2.DashboardListItemRequest{*datadogV2.NewDashboardListItemRequest(dashboardID, dashboardListItemType(layoutType))}
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
+	var diags diag.Diagnostics
 	if v, ok := d.GetOk("dashboard_lists"); ok && v.(*schema.Set).Len() > 0 {
 		items := datadogV2.NewDashboardListAddItemsRequest()
 		items.SetDashboards(itemsRequest)
 
 		for _, id := range v.(*schema.Set).List() {
-			_, _, err := apiInstances.GetDashboardListsApiV2().CreateDashboardListItems(auth, int64(id.(int)), *items)
+			_, httpresp, err := apiInstances.GetDashboardListsApiV2().CreateDashboardListItems(auth, int64(id.(int)), *items)
 			if err != nil {
-				log.Printf("[DEBUG] Got error adding to dashboard list %d: %v", id.(int), err)
+				diags = append(diags, utils.TranslateClientErrorDiag(err, httpresp, fmt.Sprintf("error adding dashboard to dashboard list %d", id.(int)))...)
 			}
 		}
 	}
@@ -234,15 +295,78 @@ func updateDashboardLists(d *schema.ResourceData, providerConf *ProviderConfigur
 		items.SetDashboards(itemsRequest)
 
 		for _, id := range v.(*schema.Set).List() {
-			_, _, err := apiInstances.GetDashboardListsApiV2().DeleteDashboardListItems(auth, int64(id.(int)), *items)
-			if err != nil {
-				log.Printf("[DEBUG] Got error removing from dashboard list %d: %v", id.(int), err)
+			_, httpresp, err := apiInstances.GetDashboardListsApiV2().DeleteDashboardListItems(auth, int64(id.(int)), *items)
+			if err != nil && (httpresp == nil || httpresp.StatusCode != 404) {
+				diags = append(diags, utils.TranslateClientErrorDiag(err, httpresp, fmt.Sprintf("error removing dashboard from dashboard list %d", id.(int)))...)
 			}
 		}
 	}
+
+	return diags
+}
+
+// updateDashboardListsState keeps the lists of `dashboard_lists` the dashboard actually belongs to, so that a
+// missing membership shows up in the plan
+func updateDashboardListsState(ctx context.Context, d *schema.ResourceData, providerConf *ProviderConfiguration, dashboardID string) diag.Diagnostics {
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	dashboardLists := make([]int, 0)
+	for _, id := range d.Get("dashboard_lists").(*schema.Set).List() {
+		items, httpresp, err := apiInstances.GetDashboardListsApiV2().GetDashboardListItems(auth, int64(id.(int)))
+		if err != nil {
+			if httpresp != nil && httpresp.StatusCode == 404 {
+				continue
+			}
+			return utils.TranslateClientErrorDiag(err, httpresp, fmt.Sprintf("error getting dashboard list %d", id.(int)))
+		}
+		// Only the dashboard IDs are used, don't fail on unparsed elements
+		for _, item := range items.GetDashboards() {
+			if item.GetId() == dashboardID {
+				dashboardLists = append(dashboardLists, id.(int))
+				break
+			}
+		}
+	}
+	if err := d.Set("dashboard_lists", dashboardLists); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// warningDiags downgrades errors to warnings, e.g. for the errors following the creation of a resource which
+// would otherwise be tainted
+func warningDiags(diags diag.Diagnostics) diag.Diagnostics {
+	for i := range diags {
+		diags[i].Severity = diag.Warning
+	}
+	return diags
 }
 
-func updateDashboardState(d *schema.ResourceData, dashboard *datadogV1.Dashboard) diag.Diagnostics {
+// parseDashboardTags sets the `tags` of the dashboard response in its AdditionalProperties, as the API client
+// doesn't decode them
+func parseDashboardTags(dashboard *datadogV1.Dashboard, httpresp *http.Response) error {
+	body, err := datadog.ReadBody(httpresp)
+	if err != nil {
+		return err
+	}
+	var raw struct {
+		Tags []string `json:"tags"`
+	}
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return fmt.Errorf("error parsing the dashboard tags: %s", err)
+	}
+	if dashboard.AdditionalProperties == nil {
+		dashboard.AdditionalProperties = make(map[string]interface{})
+	}
+	dashboard.AdditionalProperties["tags"] = raw.Tags
+	return nil
+}
+
+func updateDashboardState(d *schema.ResourceData, meta interface{}, dashboard *datadogV1.Dashboard) diag.Diagnostics {
+	// Widgets the client can't parse and their unknown nested values are kept as raw JSON, warn instead of failing
+	diags := utils.CheckForUnparsedDiag(dashboard)
+
 	if err := d.Set("title", dashboard.GetTitle()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -268,14 +392,33 @@ func updateDashboardState(d *schema.ResourceData, dashboard *datadogV1.Dashboard
 		return diag.FromErr(err)
 	}
 
-	// Set widgets
-	terraformWidgets, err := buildTerraformWidgets(&dashboard.Widgets, d)
+	// Set widgets, the trailing widgets are the ones of `widget_json`
+	widgetJSONCount := len(d.Get("widget_json").([]interface{}))
+	if widgetJSONCount > len(dashboard.Widgets) {
+		widgetJSONCount = len(dashboard.Widgets)
+	}
+	datadogWidgets := dashboard.Widgets[:len(dashboard.Widgets)-widgetJSONCount]
+	terraformWidgets, err := buildTerraformWidgets(&datadogWidgets, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if d.Get("auto_layout").(bool) {
+		removeAutoWidgetLayouts(d, *terraformWidgets)
+	}
 	if err := d.Set("widget", terraformWidgets); err != nil {
 		return diag.FromErr(err)
 	}
+	widgetJSON := make([]string, 0, widgetJSONCount)
+	for _, datadogWidget := range dashboard.Widgets[len(datadogWidgets):] {
+		terraformWidgetJSON, err := buildWidgetJSON(datadogWidget)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		widgetJSON = append(widgetJSON, terraformWidgetJSON)
+	}
+	if err := d.Set("widget_json", widgetJSON); err != nil {
+		return diag.FromErr(err)
+	}
 
 	// Set template variables
 	templateVariables := buildTerraformTemplateVariables(&dashboard.TemplateVariables)
@@ -295,13 +438,18 @@ func updateDashboardState(d *schema.ResourceData, dashboard *datadogV1.Dashboard
 		return diag.FromErr(err)
 	}
 
-	return nil
+	tags, _ := dashboard.AdditionalProperties["tags"].([]string)
+	if err := setTagsState(d, meta, tags); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
 }
 
 func resourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	id := d.Id()
 	dashboard, httpresp, err := apiInstances.GetDashboardsApiV1().GetDashboard(auth, id)
 	if err != nil {
@@ -311,17 +459,21 @@ func resourceDatadogDashboardRead(ctx context.Context, d *schema.ResourceData, m
 		}
 		return utils.TranslateClientErrorDiag(err, httpresp, "error getting dashboard")
 	}
-	if err := utils.CheckForUnparsed(dashboard); err != nil {
+	if err := parseDashboardTags(&dashboard, httpresp); err != nil {
 		return diag.FromErr(err)
 	}
 
-	return updateDashboardState(d, &dashboard)
+	diags := updateDashboardState(d, meta, &dashboard)
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, updateDashboardListsState(ctx, d, providerConf, id)...)
 }
 
 func resourceDatadogDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	id := d.Id()
 	if _, httpresp, err := apiInstances.GetDashboardsApiV1().DeleteDashboard(auth, id); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpresp, "error deleting dashboard")
@@ -329,6 +481,39 @@ func resourceDatadogDashboardDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceDatadogDashboardImport accepts a dashboard ID or `title=<exact title>`
+func resourceDatadogDashboardImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	key, value := utils.ParseImportFilter(d.Id())
+	if key == "" {
+		return []*schema.ResourceData{d}, nil
+	}
+	if key != "title" {
+		return nil, fmt.Errorf("unsupported import ID %q, expected a dashboard ID or `title=<title>`", d.Id())
+	}
+
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	dashboards, httpresp, err := apiInstances.GetDashboardsApiV1().ListDashboards(auth)
+	if err != nil {
+		return nil, utils.TranslateClientError(err, httpresp, "error querying dashboards")
+	}
+	matches := make(map[string]string)
+	for _, dashboard := range dashboards.GetDashboards() {
+		if dashboard.GetTitle() == value {
+			matches[dashboard.GetId()] = dashboard.GetTitle()
+		}
+	}
+
+	id, err := utils.UniqueImportMatch("dashboard", d.Id(), matches)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
 func buildDatadogDashboard(d *schema.ResourceData) (*datadogV1.Dashboard, error) {
 	var dashboard datadogV1.Dashboard
 
@@ -360,6 +545,22 @@ func buildDatadogDashboard(d *schema.ResourceData) (*datadogV1.Dashboard, error)
 	if err != nil {
 		return nil, err
 	}
+	// The JSON formatted widgets are displayed after the other widgets
+	for i, widgetJSON := range d.Get("widget_json").([]interface{}) {
+		var datadogWidget datadogV1.Widget
+		if err := json.Unmarshal([]byte(widgetJSON.(string)), &datadogWidget); err != nil {
+			return nil, fmt.Errorf("failed to parse widget_json.%d: %s", i, err)
+		}
+		*datadogWidgets = append(*datadogWidgets, datadogWidget)
+	}
+	if d.Get("auto_layout").(bool) {
+		grid := utils.ReflowWidgetGrid
+		if dashboard.GetLayoutType() == datadogV1.DASHBOARDLAYOUTTYPE_FREE {
+			grid = utils.FreeWidgetGrid
+		}
+		// Only the widgets of `widget` are placed, the JSON formatted ones keep their layout
+		autoLayoutDashboardWidgets(*datadogWidgets, len(terraformWidgets), grid)
+	}
 	dashboard.SetWidgets(*datadogWidgets)
 
 	// Build NotifyList
@@ -692,6 +893,19 @@ func getNonGroupWidgetSchema() map[string]*schema.Schema {
 			Computed:    true,
 			Description: "The ID of the widget.",
 		},
+		"unparsed_definition_json": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: diffSuppressUnparsedDefinitionJSON,
+			Description:      "The JSON formatted definition of a widget that this version of the provider doesn't support, e.g. a new widget type. It's set instead of a typed definition when reading such a widget, and sent as is on updates so that the widget isn't lost.",
+		},
+		"unparsed_elements_json": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON formatted elements of a typed widget definition that this version of the provider doesn't support, e.g. a new enum value of a request, by JSON pointer. They are merged back into the definition on updates so that they aren't lost, the configured values taking precedence. The elements are matched to the widget by its position.",
+		},
 		// A widget should implement exactly one of the following definitions
 		"alert_graph_definition": {
 			Type:        schema.TypeList,
@@ -1113,10 +1327,24 @@ func buildDatadogWidget(terraformWidget map[string]interface{}) (*datadogV1.Widg
 		if runWorkflowDefinition, ok := def[0].(map[string]interface{}); ok {
 			definition = datadogV1.RunWorkflowWidgetDefinitionAsWidgetDefinition(buildDatadogRunWorkflowDefinition(runWorkflowDefinition))
 		}
+	} else if def, ok := terraformWidget["unparsed_definition_json"].(string); ok && def != "" {
+		var unparsedDefinition interface{}
+		if err := json.Unmarshal([]byte(def), &unparsedDefinition); err != nil {
+			return nil, fmt.Errorf("failed to parse unparsed_definition_json: %s", err)
+		}
+		// The client sends the unparsed object as is
+		definition = datadogV1.WidgetDefinition{UnparsedObject: unparsedDefinition}
 	} else {
 		return nil, fmt.Errorf("failed to find valid definition in widget configuration")
 	}
 
+	if v, ok := terraformWidget["unparsed_elements_json"].(string); ok && v != "" && definition.UnparsedObject == nil {
+		var err error
+		if definition, err = mergeUnparsedWidgetElements(definition, v); err != nil {
+			return nil, err
+		}
+	}
+
 	datadogWidget := datadogV1.NewWidget(definition)
 
 	// Build widget layout
@@ -1166,7 +1394,23 @@ func buildTerraformWidget(datadogWidget datadogV1.Widget, k *utils.ResourceDataK
 
 	// Build definition
 	widgetDefinition := datadogWidget.GetDefinition()
-	if widgetDefinition.GroupWidgetDefinition != nil {
+	// Clear the raw JSON of the widgets this version of the provider knows
+	terraformWidget["unparsed_definition_json"] = ""
+	// Known widget types keep their typed definition, and the raw JSON of their unknown nested values so that they
+	// are sent back on updates. The unparsed values are reported by updateDashboardState.
+	unparsedElements, err := buildTerraformUnparsedWidgetElements(widgetDefinition)
+	if err != nil {
+		return nil, err
+	}
+	terraformWidget["unparsed_elements_json"] = unparsedElements
+	if widgetDefinition.UnparsedObject != nil {
+		// Keep the definitions of unknown widget types as raw JSON
+		unparsedDefinition, err := json.Marshal(widgetDefinition)
+		if err != nil {
+			return nil, err
+		}
+		terraformWidget["unparsed_definition_json"] = string(unparsedDefinition)
+	} else if widgetDefinition.GroupWidgetDefinition != nil {
 		terraformDefinition := buildTerraformGroupDefinition(*widgetDefinition.GroupWidgetDefinition, k.Add("group_definition.0"))
 		k.Remove("group_definition.0")
 		terraformWidget["group_definition"] = []map[string]interface{}{terraformDefinition}
@@ -1287,6 +1531,127 @@ func buildTerraformWidget(datadogWidget datadogV1.Widget, k *utils.ResourceDataK
 	return terraformWidget, nil
 }
 
+// Helper to build the raw JSON of the unparsed elements of a typed widget definition, by JSON pointer. The elements
+// of the widgets of a group definition are kept by the widgets themselves.
+func buildTerraformUnparsedWidgetElements(datadogDefinition datadogV1.WidgetDefinition) (string, error) {
+	if datadogDefinition.UnparsedObject != nil {
+		return "", nil
+	}
+	unparsedElements := map[string]interface{}{}
+	for _, unparsed := range utils.FindUnparsedObjects(datadogDefinition) {
+		if datadogDefinition.GroupWidgetDefinition != nil && strings.HasPrefix(unparsed.Path, "/widgets/") {
+			continue
+		}
+		unparsedElements[unparsed.Path] = unparsed.Value
+	}
+	if len(unparsedElements) == 0 {
+		return "", nil
+	}
+	unparsedElementsJSON, err := json.Marshal(unparsedElements)
+	if err != nil {
+		return "", err
+	}
+	return string(unparsedElementsJSON), nil
+}
+
+// Helper to merge the unparsed elements of a widget back into its typed definition. The definition is then sent as
+// raw JSON, the configured values taking precedence over the unparsed ones.
+func mergeUnparsedWidgetElements(datadogDefinition datadogV1.WidgetDefinition, unparsedElementsJSON string) (datadogV1.WidgetDefinition, error) {
+	var unparsedElements map[string]interface{}
+	if err := json.Unmarshal([]byte(unparsedElementsJSON), &unparsedElements); err != nil {
+		return datadogDefinition, fmt.Errorf("failed to parse unparsed_elements_json: %s", err)
+	}
+	definitionJSON, err := json.Marshal(datadogDefinition)
+	if err != nil {
+		return datadogDefinition, err
+	}
+	var definition interface{}
+	if err := json.Unmarshal(definitionJSON, &definition); err != nil {
+		return datadogDefinition, err
+	}
+
+	pointers := make([]string, 0, len(unparsedElements))
+	for pointer := range unparsedElements {
+		pointers = append(pointers, pointer)
+	}
+	sort.Strings(pointers)
+	for _, pointer := range pointers {
+		configured, err := utils.GetJSONPointer(definition, pointer)
+		if err != nil {
+			// The element was removed from the configuration
+			continue
+		}
+		if err := utils.SetJSONPointer(definition, pointer, mergeUnparsedJSON(unparsedElements[pointer], configured)); err != nil {
+			return datadogDefinition, err
+		}
+	}
+	return datadogV1.WidgetDefinition{UnparsedObject: definition}, nil
+}
+
+// mergeUnparsedJSON merges two JSON values: objects are merged key by key and arrays of the same length element by
+// element, the configured values taking precedence
+func mergeUnparsedJSON(unparsed, configured interface{}) interface{} {
+	switch c := configured.(type) {
+	case map[string]interface{}:
+		u, ok := unparsed.(map[string]interface{})
+		if !ok {
+			return configured
+		}
+		merged := make(map[string]interface{}, len(u))
+		for k, v := range u {
+			merged[k] = v
+		}
+		for k, v := range c {
+			merged[k] = mergeUnparsedJSON(u[k], v)
+		}
+		return merged
+	case []interface{}:
+		u, ok := unparsed.([]interface{})
+		if !ok || len(u) != len(c) {
+			return configured
+		}
+		merged := make([]interface{}, len(c))
+		for i := range c {
+			merged[i] = mergeUnparsedJSON(u[i], c[i])
+		}
+		return merged
+	default:
+		return configured
+	}
+}
+
+// Only compare the JSON values of unparsed definitions, so that formatting differences are ignored
+func diffSuppressUnparsedDefinitionJSON(_, old, new string, _ *schema.ResourceData) bool {
+	var oldDefinition, newDefinition interface{}
+	if err := json.Unmarshal([]byte(old), &oldDefinition); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newDefinition); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDefinition, newDefinition)
+}
+
+// buildWidgetJSON returns the canonical JSON of a widget
+func buildWidgetJSON(datadogWidget datadogV1.Widget) (string, error) {
+	widgetJSON, err := json.Marshal(datadogWidget)
+	if err != nil {
+		return "", err
+	}
+	return normalizeWidgetJSON(string(widgetJSON)), nil
+}
+
+// normalizeWidgetJSON removes the widget IDs from a JSON formatted widget and sorts its keys
+func normalizeWidgetJSON(v interface{}) string {
+	attrMap, err := structure.ExpandJsonFromString(v.(string))
+	if err != nil {
+		return v.(string)
+	}
+	deleteWidgetID([]interface{}{attrMap})
+	res, _ := structure.FlattenJsonToString(attrMap)
+	return res
+}
+
 //
 // Widget Layout helpers
 //
@@ -1294,24 +1659,28 @@ func buildTerraformWidget(datadogWidget datadogV1.Widget, k *utils.ResourceDataK
 func getWidgetLayoutSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"x": {
-			Description: "The position of the widget on the x (horizontal) axis. Should be greater than or equal to 0.",
-			Type:        schema.TypeInt,
-			Required:    true,
+			Description:  "The position of the widget on the x (horizontal) axis. Should be greater than or equal to 0.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"y": {
-			Description: "The position of the widget on the y (vertical) axis. Should be greater than or equal to 0.",
-			Type:        schema.TypeInt,
-			Required:    true,
+			Description:  "The position of the widget on the y (vertical) axis. Should be greater than or equal to 0.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
 		},
 		"width": {
-			Description: "The width of the widget.",
-			Type:        schema.TypeInt,
-			Required:    true,
+			Description:  "The width of the widget. On `ordered` dashboards and in groups, `x` plus `width` should be at most 12.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"height": {
-			Description: "The height of the widget.",
-			Type:        schema.TypeInt,
-			Required:    true,
+			Description:  "The height of the widget.",
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
 		},
 		"is_column_break": {
 			Description: "Whether the widget should be the first one on the second column in high density or not. Only for the new dashboard layout and only one widget in the dashboard should have this property set to `true`.",
@@ -1333,6 +1702,167 @@ func buildDatadogWidgetLayout(terraformLayout map[string]interface{}) *datadogV1
 	return datadogLayout
 }
 
+// validateDashboardLayoutDiff checks the geometry of the widgets: group widgets only on ordered dashboards, layouts
+// fitting the 12 columns of ordered dashboards and of groups, and layouts not overlapping on fixed grids, unless
+// `allow_overlapping_widgets` is set on a free dashboard.
+func validateDashboardLayoutDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, k := range []string{"widget", "layout_type", "reflow_type", "auto_layout", "allow_overlapping_widgets"} {
+		if !diff.NewValueKnown(k) {
+			return nil
+		}
+	}
+	free := diff.Get("layout_type").(string) == string(datadogV1.DASHBOARDLAYOUTTYPE_FREE)
+	fixed := free || diff.Get("reflow_type").(string) == string(datadogV1.DASHBOARDREFLOWTYPE_FIXED)
+	autoLayout := diff.Get("auto_layout").(bool)
+	if autoLayout && !fixed {
+		return fmt.Errorf("`auto_layout` is only supported on free dashboards and on ordered dashboards with a fixed reflow type")
+	}
+	allowOverlaps := diff.Get("allow_overlapping_widgets").(bool)
+	if allowOverlaps && !free {
+		return fmt.Errorf("`allow_overlapping_widgets` is only supported on free dashboards")
+	}
+	grid := utils.ReflowWidgetGrid
+	if free {
+		grid = utils.FreeWidgetGrid
+	}
+
+	widgets := diff.Get("widget").([]interface{})
+	errs := validateWidgetLayoutsDiff(diff, "widget", widgets, grid, fixed && !allowOverlaps, fixed && !autoLayout)
+	columnBreaks := 0
+	for i, w := range widgets {
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if wl, ok := widget["widget_layout"].([]interface{}); ok && len(wl) > 0 {
+			if layout, ok := wl[0].(map[string]interface{}); ok && layout["is_column_break"] == true {
+				columnBreaks++
+			}
+		}
+		def, ok := widget["group_definition"].([]interface{})
+		if !ok || len(def) == 0 {
+			continue
+		}
+		if free {
+			errs = append(errs, fmt.Errorf("widget.%d: group widgets are only supported on ordered dashboards", i))
+			continue
+		}
+		if groupDefinition, ok := def[0].(map[string]interface{}); ok {
+			path := fmt.Sprintf("widget.%d.group_definition.0.widget", i)
+			groupWidgets, _ := groupDefinition["widget"].([]interface{})
+			errs = append(errs, validateWidgetLayoutsDiff(diff, path, groupWidgets, utils.ReflowWidgetGrid, fixed, fixed && !autoLayout)...)
+		}
+	}
+	if columnBreaks > 1 {
+		errs = append(errs, fmt.Errorf("only one widget can have `is_column_break` set, got %d", columnBreaks))
+	}
+
+	var messages []string
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("invalid widget layouts:\n%s", strings.Join(messages, "\n"))
+	}
+	return nil
+}
+
+// validateWidgetLayoutsDiff checks the layouts of sibling widgets against the bounds of grid and, when overlaps is
+// set, against each other. The layouts not known yet are skipped.
+func validateWidgetLayoutsDiff(diff *schema.ResourceDiff, path string, widgets []interface{}, grid utils.WidgetGrid, overlaps, required bool) []error {
+	var errs []error
+	var layouts []utils.PlacedWidgetLayout
+	for i, w := range widgets {
+		widgetPath := fmt.Sprintf("%s.%d", path, i)
+		widget, _ := w.(map[string]interface{})
+		layout, ok := terraformWidgetLayout(widget)
+		if !ok {
+			if required {
+				errs = append(errs, fmt.Errorf("%s: `widget_layout` is required on free dashboards and on ordered dashboards with a fixed reflow type, unless `auto_layout` is set", widgetPath))
+			}
+			continue
+		}
+		if !diff.NewValueKnown(widgetPath + ".widget_layout") {
+			continue
+		}
+		placed := utils.PlacedWidgetLayout{Path: widgetPath, Layout: layout}
+		if overlaps {
+			layouts = append(layouts, placed)
+		} else {
+			errs = append(errs, utils.ValidateWidgetLayouts([]utils.PlacedWidgetLayout{placed}, grid)...)
+		}
+	}
+	return append(errs, utils.ValidateWidgetLayouts(layouts, grid)...)
+}
+
+func terraformWidgetLayout(widget map[string]interface{}) (utils.WidgetLayout, bool) {
+	wl, ok := widget["widget_layout"].([]interface{})
+	if !ok || len(wl) == 0 {
+		return utils.WidgetLayout{}, false
+	}
+	layout, ok := wl[0].(map[string]interface{})
+	if !ok {
+		return utils.WidgetLayout{}, false
+	}
+	return utils.WidgetLayout{
+		X:      int64(layout["x"].(int)),
+		Y:      int64(layout["y"].(int)),
+		Width:  int64(layout["width"].(int)),
+		Height: int64(layout["height"].(int)),
+	}, true
+}
+
+// autoLayoutDashboardWidgets places the first count widgets without layout around the other ones, see
+// utils.PackWidgetLayouts. The widgets of groups are placed first, so that groups are as high as their widgets.
+func autoLayoutDashboardWidgets(widgets []datadogV1.Widget, count int, grid utils.WidgetGrid) {
+	var placed []utils.WidgetLayout
+	var unplaced []int
+	var sizes []utils.WidgetSize
+	for i := range widgets {
+		size := grid.WidgetSize
+		if group := widgets[i].Definition.GroupWidgetDefinition; group != nil && i < count {
+			autoLayoutDashboardWidgets(group.Widgets, len(group.Widgets), utils.ReflowWidgetGrid)
+			// Groups span the whole grid, below their title
+			size = utils.WidgetSize{Width: utils.ReflowColumns, Height: 1}
+			for _, groupWidget := range group.Widgets {
+				if l, ok := groupWidget.GetLayoutOk(); ok && l.GetY()+l.GetHeight()+1 > size.Height {
+					size.Height = l.GetY() + l.GetHeight() + 1
+				}
+			}
+		}
+		if l, ok := widgets[i].GetLayoutOk(); ok {
+			placed = append(placed, utils.WidgetLayout{X: l.GetX(), Y: l.GetY(), Width: l.GetWidth(), Height: l.GetHeight()})
+		} else if i < count {
+			unplaced = append(unplaced, i)
+			sizes = append(sizes, size)
+		}
+	}
+	for i, layout := range utils.PackWidgetLayouts(placed, sizes, grid) {
+		widgets[unplaced[i]].SetLayout(*datadogV1.NewWidgetLayout(layout.Height, layout.Width, layout.X, layout.Y))
+	}
+}
+
+// removeAutoWidgetLayouts removes the layouts placed by `auto_layout` from the Terraform widgets, i.e. the layouts
+// of the widgets without `widget_layout` in the configuration or the state
+func removeAutoWidgetLayouts(d *schema.ResourceData, terraformWidgets []map[string]interface{}) {
+	for i, terraformWidget := range terraformWidgets {
+		path := fmt.Sprintf("widget.%d", i)
+		if len(d.Get(path+".widget_layout").([]interface{})) == 0 {
+			delete(terraformWidget, "widget_layout")
+		}
+		groupDefinition, ok := terraformWidget["group_definition"].([]map[string]interface{})
+		if !ok || len(groupDefinition) == 0 {
+			continue
+		}
+		groupWidgets, _ := groupDefinition[0]["widget"].([]map[string]interface{})
+		for j, groupWidget := range groupWidgets {
+			if len(d.Get(fmt.Sprintf("%s.group_definition.0.widget.%d.widget_layout", path, j)).([]interface{})) == 0 {
+				delete(groupWidget, "widget_layout")
+			}
+		}
+	}
+}
+
 //
 // Group Widget helpers
 //
@@ -1783,18 +2313,7 @@ func buildDatadogChangeRequests(terraformRequests *[]interface{}) *[]datadogV1.C
 			processQuery := v[0].(map[string]interface{})
 			datadogChangeRequest.ProcessQuery = buildDatadogProcessQuery(processQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogChangeRequest.SetQueries(queries)
+			datadogChangeRequest.SetQueries(buildDatadogQuery(v))
 			// Change request for formulas and functions always have a response format of "scalar"
 			datadogChangeRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
@@ -2719,6 +3238,7 @@ func getHostmapDefinitionSchema() map[string]*schema.Schema {
 						Description: "The query used to fill the map. Exactly one nested block is allowed using the structure below (exactly one of `q`, `apm_query`, `log_query`, `rum_query`, `security_query` or `process_query` is required within the request block).",
 						Type:        schema.TypeList,
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: getHostmapRequestSchema(),
 						},
@@ -2727,6 +3247,7 @@ func getHostmapDefinitionSchema() map[string]*schema.Schema {
 						Description: "The query used to size the map. Exactly one nested block is allowed using the structure below (exactly one of `q`, `apm_query`, `log_query`, `rum_query`, `security_query` or `process_query` is required within the request block).",
 						Type:        schema.TypeList,
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: getHostmapRequestSchema(),
 						},
@@ -3858,18 +4379,7 @@ func buildDatadogQueryValueRequests(terraformRequests *[]interface{}) *[]datadog
 			auditQuery := v[0].(map[string]interface{})
 			datadogQueryValueRequest.AuditQuery = buildDatadogApmOrLogQuery(auditQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogQueryValueRequest.SetQueries(queries)
+			datadogQueryValueRequest.SetQueries(buildDatadogQuery(v))
 			// Query Value requests for formulas and functions always has a response format of "scalar"
 			datadogQueryValueRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
@@ -4126,22 +4636,7 @@ func buildDatadogQueryTableRequests(terraformRequests *[]interface{}) *[]datadog
 			apmStatsQuery := v[0].(map[string]interface{})
 			datadogQueryTableRequest.ApmStatsQuery = buildDatadogApmStatsQuery(apmStatsQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["apm_dependency_stats_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionAPMDependencyStatsQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["apm_resource_stats_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionAPMResourceStatsQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogQueryTableRequest.SetQueries(queries)
+			datadogQueryTableRequest.SetQueries(buildDatadogQuery(v))
 			// Query Table request for formulas and functions always have a response format of "scalar"
 			datadogQueryTableRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
@@ -4264,6 +4759,7 @@ func getScatterplotDefinitionSchema() map[string]*schema.Schema {
 						Description: "The query used for the X-Axis. Exactly one nested block is allowed using the structure below (exactly one of `q`, `apm_query`, `log_query`, `rum_query`, `security_query`, `apm_stats_query` or `process_query` is required within the block).",
 						Type:        schema.TypeList,
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: getScatterplotRequestSchema(),
 						},
@@ -4272,6 +4768,7 @@ func getScatterplotDefinitionSchema() map[string]*schema.Schema {
 						Description: "The query used for the Y-Axis. Exactly one nested block is allowed using the structure below (exactly one of `q`, `apm_query`, `log_query`, `rum_query`, `security_query`, `apm_stats_query` or `process_query` is required within the block).",
 						Type:        schema.TypeList,
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: getScatterplotRequestSchema(),
 						},
@@ -4280,6 +4777,7 @@ func getScatterplotDefinitionSchema() map[string]*schema.Schema {
 						Description: "Scatterplot request containing formulas and functions.",
 						Type:        schema.TypeList,
 						Optional:    true,
+						MaxItems:    1,
 						Elem: &schema.Resource{
 							Schema: getScatterplotTableRequestSchema(),
 						},
@@ -4474,7 +4972,7 @@ func getScatterplotRequestSchema() map[string]*schema.Schema {
 		"aggregator": {
 			Description:      "Aggregator used for the request.",
 			Type:             schema.TypeString,
-			ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewWidgetAggregatorFromValue),
+			ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewScatterplotWidgetAggregatorFromValue),
 			Optional:         true,
 		},
 	}
@@ -4484,18 +4982,7 @@ func buildDatadogScatterplotTableRequest(terraformRequest map[string]interface{}
 	datadogScatterplotTableRequest := datadogV1.NewScatterplotTableRequest()
 
 	if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-		queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-		for i, q := range v {
-			query := q.(map[string]interface{})
-			if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-				queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-			} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-				queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-			} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-				queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-			}
-		}
-		datadogScatterplotTableRequest.SetQueries(queries)
+		datadogScatterplotTableRequest.SetQueries(buildDatadogQuery(v))
 		datadogScatterplotTableRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 	}
 
@@ -4734,6 +5221,7 @@ func getTopologyQuerySchema() *schema.Schema {
 		Description: "The query for a Topology request.",
 		Type:        schema.TypeList,
 		Required:    true,
+		MaxItems:    1,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"data_source": {
@@ -5471,18 +5959,7 @@ func buildDatadogGeomapRequests(terraformRequests *[]interface{}) *[]datadogV1.G
 			rumQuery := v[0].(map[string]interface{})
 			datadogGeomapRequest.RumQuery = buildDatadogApmOrLogQuery(rumQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogGeomapRequest.SetQueries(queries)
+			datadogGeomapRequest.SetQueries(buildDatadogQuery(v))
 			// Geomap requests for formulas and functions always has a response format of "scalar"
 			datadogGeomapRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
@@ -5961,18 +6438,7 @@ func buildDatadogSunburstRequests(terraformRequests *[]interface{}) *[]datadogV1
 			auditQuery := v[0].(map[string]interface{})
 			datadogSunburstRequest.AuditQuery = buildDatadogApmOrLogQuery(auditQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogSunburstRequest.SetQueries(queries)
+			datadogSunburstRequest.SetQueries(buildDatadogQuery(v))
 			datadogSunburstRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
 		if v, ok := terraformRequest["formula"].([]interface{}); ok && len(v) > 0 {
@@ -6226,10 +6692,11 @@ func getFormulaQuerySchema() *schema.Schema {
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							"data_source": {
-								Type:        schema.TypeString,
-								Optional:    true,
-								Default:     "metrics",
-								Description: "The data source for metrics queries.",
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "metrics",
+								ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewFormulaAndFunctionMetricDataSourceFromValue),
+								Description:      "The data source for metrics queries.",
 							},
 							"query": {
 								Type:        schema.TypeString,
@@ -6293,6 +6760,7 @@ func getFormulaQuerySchema() *schema.Schema {
 							"compute": {
 								Type:        schema.TypeList,
 								Required:    true,
+								MaxItems:    1,
 								Description: "The compute options.",
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
@@ -6904,18 +7372,7 @@ func buildDatadogTimeseriesRequests(terraformRequests *[]interface{}) *[]datadog
 			auditQuery := v[0].(map[string]interface{})
 			datadogTimeseriesRequest.AuditQuery = buildDatadogApmOrLogQuery(auditQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogTimeseriesRequest.SetQueries(queries)
+			datadogTimeseriesRequest.SetQueries(buildDatadogQuery(v))
 			datadogTimeseriesRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("timeseries"))
 		}
 		if v, ok := terraformRequest["formula"].([]interface{}); ok && len(v) > 0 {
@@ -7184,18 +7641,7 @@ func buildDatadogToplistRequests(terraformRequests *[]interface{}) *[]datadogV1.
 			auditQuery := v[0].(map[string]interface{})
 			datadogToplistRequest.AuditQuery = buildDatadogApmOrLogQuery(auditQuery)
 		} else if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogToplistRequest.SetQueries(queries)
+			datadogToplistRequest.SetQueries(buildDatadogQuery(v))
 			// Toplist requests for formulas and functions always has a response format of "scalar"
 			datadogToplistRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
@@ -7588,18 +8034,7 @@ func buildDatadogTreemapRequests(terraformRequests *[]interface{}) *[]datadogV1.
 		// Build Treemap request
 		datadogTreemapRequest := datadogV1.NewTreeMapWidgetRequest()
 		if v, ok := terraformRequest["query"].([]interface{}); ok && len(v) > 0 {
-			queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(v))
-			for i, q := range v {
-				query := q.(map[string]interface{})
-				if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
-				} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
-					queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
-				}
-			}
-			datadogTreemapRequest.SetQueries(queries)
+			datadogTreemapRequest.SetQueries(buildDatadogQuery(v))
 			datadogTreemapRequest.SetResponseFormat(datadogV1.FormulaAndFunctionResponseFormat("scalar"))
 		}
 		if v, ok := terraformRequest["formula"].([]interface{}); ok && len(v) > 0 {
@@ -8308,6 +8743,26 @@ func buildDatadogGroupBySort(sort map[string]interface{}) *datadogV1.LogQueryDef
 	return ddSort
 }
 
+// buildDatadogQuery builds the formula and function queries of a request, the reverse of buildTerraformQuery
+func buildDatadogQuery(terraformQueries []interface{}) []datadogV1.FormulaAndFunctionQueryDefinition {
+	queries := make([]datadogV1.FormulaAndFunctionQueryDefinition, len(terraformQueries))
+	for i, q := range terraformQueries {
+		query := q.(map[string]interface{})
+		if w, ok := query["event_query"].([]interface{}); ok && len(w) > 0 {
+			queries[i] = buildDatadogEventQuery(w[0].(map[string]interface{}))
+		} else if w, ok := query["metric_query"].([]interface{}); ok && len(w) > 0 {
+			queries[i] = buildDatadogMetricQuery(w[0].(map[string]interface{}))
+		} else if w, ok := query["process_query"].([]interface{}); ok && len(w) > 0 {
+			queries[i] = buildDatadogFormulaAndFunctionProcessQuery(w[0].(map[string]interface{}))
+		} else if w, ok := query["apm_dependency_stats_query"].([]interface{}); ok && len(w) > 0 {
+			queries[i] = buildDatadogFormulaAndFunctionAPMDependencyStatsQuery(w[0].(map[string]interface{}))
+		} else if w, ok := query["apm_resource_stats_query"].([]interface{}); ok && len(w) > 0 {
+			queries[i] = buildDatadogFormulaAndFunctionAPMResourceStatsQuery(w[0].(map[string]interface{}))
+		}
+	}
+	return queries
+}
+
 func buildTerraformQuery(datadogQueries []datadogV1.FormulaAndFunctionQueryDefinition) []map[string]interface{} {
 	queries := make([]map[string]interface{}, len(datadogQueries))
 	for i, query := range datadogQueries {
@@ -8874,17 +9329,18 @@ func buildTerraformApmStatsQuery(datadogQuery datadogV1.ApmStatsQueryDefinition)
 		terraformColumns := make([]interface{}, len(*v))
 		for i, datadogColumn := range *v {
 			terraformColumn := map[string]interface{}{}
+			// Dereference the values, datadogColumn is reused by every iteration
 			if name, nameOk := datadogColumn.GetNameOk(); nameOk {
-				terraformColumn["name"] = name
+				terraformColumn["name"] = *name
 			}
 			if alias, aliasOk := datadogColumn.GetAliasOk(); aliasOk {
-				terraformColumn["alias"] = alias
+				terraformColumn["alias"] = *alias
 			}
 			if cellDisplayMode, cellDisplayModeOk := datadogColumn.GetCellDisplayModeOk(); cellDisplayModeOk {
-				terraformColumn["cell_display_mode"] = cellDisplayMode
+				terraformColumn["cell_display_mode"] = *cellDisplayMode
 			}
 			if order, orderOk := datadogColumn.GetOrderOk(); orderOk {
-				terraformColumn["order"] = order
+				terraformColumn["order"] = *order
 			}
 			terraformColumns[i] = terraformColumn
 		}
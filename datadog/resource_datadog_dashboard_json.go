@@ -83,10 +83,14 @@ func resourceDatadogDashboardJSON() *schema.Resource {
 
 func deleteWidgetID(widgets []interface{}) {
 	for _, w := range widgets {
-		widget := w.(map[string]interface{})
-		def := widget["definition"].(map[string]interface{})
-		if def["type"] == "group" {
-			deleteWidgetID(def["widgets"].([]interface{}))
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if def, ok := widget["definition"].(map[string]interface{}); ok && def["type"] == "group" {
+			if groupWidgets, ok := def["widgets"].([]interface{}); ok {
+				deleteWidgetID(groupWidgets)
+			}
 		}
 		delete(widget, "id")
 	}
@@ -95,7 +99,7 @@ func deleteWidgetID(widgets []interface{}) {
 func resourceDatadogDashboardJSONRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 
@@ -113,13 +117,16 @@ func resourceDatadogDashboardJSONRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	return updateDashboardJSONState(d, respMap)
+	if diags := updateDashboardJSONState(d, respMap); diags.HasError() {
+		return diags
+	}
+	return updateDashboardListsState(ctx, d, providerConf, id)
 }
 
 func resourceDatadogDashboardJSONCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	dashboard := d.Get("dashboard").(string)
 
@@ -155,23 +162,28 @@ func resourceDatadogDashboardJSONCreate(ctx context.Context, d *schema.ResourceD
 			return resource.NonRetryableError(err)
 		}
 
-		// We only log the error, as failing to update the list shouldn't fail dashboard creation
-		// Method imported from dashboard resource
-		updateDashboardLists(d, providerConf, id.(string), layoutType.(string))
-
 		return nil
 	})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return updateDashboardJSONState(d, respMap)
+	// Failing to update the lists only warns, as the dashboard would otherwise be tainted
+	// Methods imported from dashboard resource
+	diags := warningDiags(updateDashboardLists(ctx, d, providerConf, id.(string), layoutType.(string)))
+
+	stateDiags := updateDashboardJSONState(d, respMap)
+	diags = append(diags, stateDiags...)
+	if stateDiags.HasError() {
+		return diags
+	}
+	return append(diags, updateDashboardListsState(ctx, d, providerConf, id.(string))...)
 }
 
 func resourceDatadogDashboardJSONUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	dashboard := d.Get("dashboard").(string)
 	id := d.Id()
@@ -191,16 +203,21 @@ func resourceDatadogDashboardJSONUpdate(ctx context.Context, d *schema.ResourceD
 		return diag.FromErr(errors.New("error retrieving layout_type from response"))
 	}
 
-	// Method imported from dashboard resource
-	updateDashboardLists(d, providerConf, id, layoutType.(string))
+	// Methods imported from dashboard resource
+	diags := updateDashboardLists(ctx, d, providerConf, id, layoutType.(string))
 
-	return updateDashboardJSONState(d, respMap)
+	stateDiags := updateDashboardJSONState(d, respMap)
+	diags = append(diags, stateDiags...)
+	if stateDiags.HasError() {
+		return diags
+	}
+	return append(diags, updateDashboardListsState(ctx, d, providerConf, id)...)
 }
 
 func resourceDatadogDashboardJSONDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 
@@ -56,7 +56,7 @@ func resourceDatadogDashboardList() *schema.Resource {
 func resourceDatadogDashboardListCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	dashboardListPayload, err := buildDatadogDashboardList(d)
 	if err != nil {
@@ -90,7 +90,7 @@ func resourceDatadogDashboardListCreate(ctx context.Context, d *schema.ResourceD
 func resourceDatadogDashboardListUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -141,7 +141,7 @@ func resourceDatadogDashboardListUpdate(ctx context.Context, d *schema.ResourceD
 func resourceDatadogDashboardListRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 
@@ -175,7 +175,7 @@ func resourceDatadogDashboardListRead(ctx context.Context, d *schema.ResourceDat
 func resourceDatadogDashboardListDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id, _ := strconv.ParseInt(d.Id(), 10, 64)
 	// Deleting the overall List will also take care of deleting its sub elements
@@ -0,0 +1,138 @@
+package datadog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceDatadogDashboardListItem() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog dashboard list item resource. This can be used to add a single dashboard to a dashboard list, e.g. when the dashboard and the list are managed in different modules. Don't use it for lists whose dashboards are set in the `dash_item` of a `datadog_dashboard_list` resource, or for dashboards whose lists are set in `dashboard_lists`. When the list is managed by a `datadog_dashboard_list` resource, ignore the changes of its `dash_item` with a `lifecycle` block.",
+		CreateContext: resourceDatadogDashboardListItemCreate,
+		ReadContext:   resourceDatadogDashboardListItemRead,
+		DeleteContext: resourceDatadogDashboardListItemDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"list_id": {
+				Description: "The ID of the dashboard list.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dashboard_id": {
+				Description: "The ID of the dashboard.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"type": {
+				Description:      "The type of the dashboard. Defaults to the type matching the layout of the dashboard, for custom dashboards.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: validators.ValidateEnumValue(datadogV2.NewDashboardTypeFromValue),
+			},
+		},
+	}
+}
+
+func resourceDatadogDashboardListItemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	listID := int64(d.Get("list_id").(int))
+	dashboardID := d.Get("dashboard_id").(string)
+	dashboardType := datadogV2.DashboardType(d.Get("type").(string))
+	if dashboardType == "" {
+		dashboard, httpresp, err := apiInstances.GetDashboardsApiV1().GetDashboard(auth, dashboardID)
+		if err != nil {
+			return utils.TranslateClientErrorDiag(err, httpresp, "error getting dashboard")
+		}
+		dashboardType = dashboardListItemType(string(dashboard.GetLayoutType()))
+	}
+
+	items := datadogV2.NewDashboardListAddItemsRequest()
+	items.SetDashboards([]datadogV2.DashboardListItemRequest{*datadogV2.NewDashboardListItemRequest(dashboardID, dashboardType)})
+	resp, httpresp, err := apiInstances.GetDashboardListsApiV2().CreateDashboardListItems(auth, listID, *items)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error adding dashboard to dashboard list")
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%d:%s", listID, dashboardID))
+	return resourceDatadogDashboardListItemRead(ctx, d, meta)
+}
+
+func resourceDatadogDashboardListItemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	listID, dashboardID, err := utils.DashboardListAndDashboardFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items, httpresp, err := apiInstances.GetDashboardListsApiV2().GetDashboardListItems(auth, listID)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting dashboard list items")
+	}
+	// Only the dashboard IDs and types are used, don't fail on unparsed elements
+	for _, item := range items.GetDashboards() {
+		if item.GetId() != dashboardID {
+			continue
+		}
+		if err := d.Set("list_id", listID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("dashboard_id", dashboardID); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("type", item.GetType()); err != nil {
+			return diag.FromErr(err)
+		}
+		return nil
+	}
+
+	// The dashboard was removed from the list
+	d.SetId("")
+	return nil
+}
+
+func resourceDatadogDashboardListItemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	listID, dashboardID, err := utils.DashboardListAndDashboardFromID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	items := datadogV2.NewDashboardListDeleteItemsRequest()
+	items.SetDashboards([]datadogV2.DashboardListItemRequest{*datadogV2.NewDashboardListItemRequest(dashboardID, datadogV2.DashboardType(d.Get("type").(string)))})
+	if _, httpresp, err := apiInstances.GetDashboardListsApiV2().DeleteDashboardListItems(auth, listID, *items); err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error removing dashboard from dashboard list")
+	}
+	return nil
+}
@@ -0,0 +1,449 @@
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const downtimeSchedulePath = "/api/v2/downtime"
+
+// downtimeScheduleResponse is the part of the v2 downtime API response used by the resource. The API client
+// doesn't include the v2 downtime API yet, so the requests are sent with `utils.SendRequest`.
+type downtimeScheduleResponse struct {
+	Data struct {
+		Id         string `json:"id"`
+		Attributes struct {
+			Scope             string `json:"scope"`
+			MonitorIdentifier struct {
+				MonitorId   *int64   `json:"monitor_id"`
+				MonitorTags []string `json:"monitor_tags"`
+			} `json:"monitor_identifier"`
+			Schedule *struct {
+				Recurrences []struct {
+					Duration string `json:"duration"`
+					Rrule    string `json:"rrule"`
+					Start    string `json:"start"`
+				} `json:"recurrences"`
+				Timezone string  `json:"timezone"`
+				Start    string  `json:"start"`
+				End      *string `json:"end"`
+			} `json:"schedule"`
+			Message                       string   `json:"message"`
+			DisplayTimezone               string   `json:"display_timezone"`
+			MuteFirstRecoveryNotification bool     `json:"mute_first_recovery_notification"`
+			NotifyEndStates               []string `json:"notify_end_states"`
+			NotifyEndTypes                []string `json:"notify_end_types"`
+			Status                        string   `json:"status"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func resourceDatadogDowntimeSchedule() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog downtime schedule resource, based on the v2 downtime API. This can be used to create and manage one-time and recurring Datadog downtimes.",
+		CreateContext: resourceDatadogDowntimeScheduleCreate,
+		ReadContext:   resourceDatadogDowntimeScheduleRead,
+		UpdateContext: resourceDatadogDowntimeScheduleUpdate,
+		DeleteContext: resourceDatadogDowntimeScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Description: "The scope to which the downtime applies, e.g. `env:prod AND service:web`. Must follow the [common search syntax](https://docs.datadoghq.com/logs/explorer/search_syntax/).",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"monitor_identifier": {
+				Description: "The monitors silenced by the downtime.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"monitor_id": {
+							Description:  "The ID of the monitor to silence.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ExactlyOneOf: []string{"monitor_identifier.0.monitor_id", "monitor_identifier.0.monitor_tags"},
+						},
+						"monitor_tags": {
+							Description: "A list of monitor tags. The monitors with all of these tags are silenced, use `*` for all the monitors.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"recurring_schedule": {
+				Description:  "A recurring schedule, made of RRULE-based recurrences.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"recurring_schedule", "one_time_schedule"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurrence": {
+							Description: "A recurrence of the downtime.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"duration": {
+										Description: "The length of each downtime, as an integer followed by a unit, e.g. `123s`, `1h` or `2d`.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"rrule": {
+										Description: "The RRULE standard for defining recurring events, e.g. `FREQ=MONTHLY;INTERVAL=1` for the first day of each month. Attributes specifying the duration in RRULE aren't supported, e.g. `DTSTART`, `DTEND` or `DURATION`.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"start": {
+										Description:      "The date and time of the first downtime, in the schedule timezone and without UTC offset, e.g. `2023-03-01T09:00:00`. Defaults to the creation of the downtime.",
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ValidateFunc:     validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}(:\d{2})?$`), "must be a date and time without UTC offset, e.g. `2023-03-01T09:00:00`"),
+										DiffSuppressFunc: suppressEquivalentDowntimeDatetime,
+									},
+								},
+							},
+						},
+						"timezone": {
+							Description:  "The timezone of the recurrences, following IANA timezone database identifiers.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "UTC",
+							ValidateFunc: validators.ValidateDatadogDowntimeTimezone,
+						},
+					},
+				},
+			},
+			"one_time_schedule": {
+				Description: "A one-time schedule.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start": {
+							Description:      "The date and time of the start of the downtime in RFC3339 format, with a UTC offset of zero. Defaults to the creation of the downtime.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentDowntimeDatetime,
+						},
+						"end": {
+							Description:      "The date and time of the end of the downtime in RFC3339 format, with a UTC offset of zero. The downtime doesn't end when not set.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.IsRFC3339Time,
+							DiffSuppressFunc: suppressEquivalentDowntimeDatetime,
+						},
+					},
+				},
+			},
+			"display_timezone": {
+				Description:  "The timezone in which the downtime is displayed in the Datadog UI, following IANA timezone database identifiers.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validators.ValidateDatadogDowntimeTimezone,
+			},
+			"message": {
+				Description: "A message to include with the notifications of the downtime. Supports the `@username` notifications allowed elsewhere.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"mute_first_recovery_notification": {
+				Description: "Whether the first recovery notification during the downtime is muted.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"notify_end_states": {
+				Description: "The monitor states which trigger a notification when the downtime ends. Defaults to all the states.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validators.ValidateStringEnumValue("alert", "no data", "warn"),
+				},
+			},
+			"notify_end_types": {
+				Description: "The ways a downtime ends which trigger a notification. Defaults to `canceled` and `expired`.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validators.ValidateStringEnumValue("canceled", "expired"),
+				},
+			},
+		},
+	}
+}
+
+// downtimeDatetimeLayouts are the formats of the dates of the v2 downtime API: recurrence starts don't
+// have a UTC offset and may not have seconds
+var downtimeDatetimeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+func parseDowntimeDatetime(value string) (time.Time, bool) {
+	for _, layout := range downtimeDatetimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// suppressEquivalentDowntimeDatetime ignores the formatting differences of the dates returned by the API,
+// e.g. `2023-03-01T09:00:00Z` and `2023-03-01T09:00:00.000Z`
+func suppressEquivalentDowntimeDatetime(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, ok := parseDowntimeDatetime(old)
+	if !ok {
+		return false
+	}
+	newTime, ok := parseDowntimeDatetime(new)
+	return ok && oldTime.Equal(newTime)
+}
+
+func buildDowntimeScheduleAttributes(d *schema.ResourceData, update bool) map[string]interface{} {
+	attributes := make(map[string]interface{})
+	if !update || d.HasChange("scope") {
+		attributes["scope"] = d.Get("scope").(string)
+	}
+	if !update || d.HasChange("monitor_identifier") {
+		monitorIdentifier := make(map[string]interface{})
+		if v, ok := d.GetOk("monitor_identifier.0.monitor_id"); ok {
+			monitorIdentifier["monitor_id"] = v.(int)
+		} else {
+			monitorIdentifier["monitor_tags"] = d.Get("monitor_identifier.0.monitor_tags").(*schema.Set).List()
+		}
+		attributes["monitor_identifier"] = monitorIdentifier
+	}
+	if !update || d.HasChanges("recurring_schedule", "one_time_schedule") {
+		schedule := make(map[string]interface{})
+		if _, ok := d.GetOk("recurring_schedule"); ok {
+			var recurrences []map[string]interface{}
+			for _, r := range d.Get("recurring_schedule.0.recurrence").([]interface{}) {
+				recurrence := r.(map[string]interface{})
+				tfRecurrence := map[string]interface{}{
+					"duration": recurrence["duration"],
+					"rrule":    recurrence["rrule"],
+				}
+				if start, ok := recurrence["start"].(string); ok && start != "" {
+					tfRecurrence["start"] = start
+				}
+				recurrences = append(recurrences, tfRecurrence)
+			}
+			schedule["recurrences"] = recurrences
+			schedule["timezone"] = d.Get("recurring_schedule.0.timezone").(string)
+		} else {
+			if start, ok := d.GetOk("one_time_schedule.0.start"); ok {
+				schedule["start"] = start.(string)
+			}
+			if end, ok := d.GetOk("one_time_schedule.0.end"); ok {
+				schedule["end"] = end.(string)
+			} else {
+				schedule["end"] = nil
+			}
+		}
+		attributes["schedule"] = schedule
+	}
+	if !update || d.HasChange("display_timezone") {
+		attributes["display_timezone"] = d.Get("display_timezone").(string)
+	}
+	if !update || d.HasChange("message") {
+		attributes["message"] = d.Get("message").(string)
+	}
+	if !update || d.HasChange("mute_first_recovery_notification") {
+		attributes["mute_first_recovery_notification"] = d.Get("mute_first_recovery_notification").(bool)
+	}
+	if v, ok := d.GetOk("notify_end_states"); ok && (!update || d.HasChange("notify_end_states")) {
+		attributes["notify_end_states"] = v.(*schema.Set).List()
+	}
+	if v, ok := d.GetOk("notify_end_types"); ok && (!update || d.HasChange("notify_end_types")) {
+		attributes["notify_end_types"] = v.(*schema.Set).List()
+	}
+	return attributes
+}
+
+func resourceDatadogDowntimeScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	body := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "downtime",
+			"attributes": buildDowntimeScheduleAttributes(d, false),
+		},
+	}
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "POST", downtimeSchedulePath, &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error creating downtime schedule")
+	}
+
+	var resp downtimeScheduleResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resp.Data.Id)
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func resourceDatadogDowntimeScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", downtimeSchedulePath+"/"+d.Id(), nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting downtime schedule")
+	}
+
+	var resp downtimeScheduleResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+	if resp.Data.Attributes.Status == "canceled" {
+		// Canceled downtimes can't be updated anymore
+		d.SetId("")
+		return nil
+	}
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func resourceDatadogDowntimeScheduleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	body := map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":         d.Id(),
+			"type":       "downtime",
+			"attributes": buildDowntimeScheduleAttributes(d, true),
+		},
+	}
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "PATCH", downtimeSchedulePath+"/"+d.Id(), &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error updating downtime schedule")
+	}
+
+	var resp downtimeScheduleResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return updateDowntimeScheduleState(d, &resp)
+}
+
+func resourceDatadogDowntimeScheduleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	_, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "DELETE", downtimeSchedulePath+"/"+d.Id(), nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error canceling downtime schedule")
+	}
+
+	return nil
+}
+
+// updateDowntimeScheduleState sets the definition of the downtime. The current downtime of recurring
+// schedules isn't stored, so plans don't change when a recurrence rolls over.
+func updateDowntimeScheduleState(d *schema.ResourceData, resp *downtimeScheduleResponse) diag.Diagnostics {
+	attributes := resp.Data.Attributes
+
+	if err := d.Set("scope", attributes.Scope); err != nil {
+		return diag.FromErr(err)
+	}
+	monitorIdentifier := make(map[string]interface{})
+	if attributes.MonitorIdentifier.MonitorId != nil {
+		monitorIdentifier["monitor_id"] = int(*attributes.MonitorIdentifier.MonitorId)
+	} else {
+		monitorIdentifier["monitor_tags"] = attributes.MonitorIdentifier.MonitorTags
+	}
+	if err := d.Set("monitor_identifier", []interface{}{monitorIdentifier}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if schedule := attributes.Schedule; schedule != nil && len(schedule.Recurrences) > 0 {
+		var recurrences []map[string]interface{}
+		for _, recurrence := range schedule.Recurrences {
+			tfRecurrence := map[string]interface{}{
+				"duration": recurrence.Duration,
+				"rrule":    recurrence.Rrule,
+			}
+			if start, ok := parseDowntimeDatetime(recurrence.Start); ok {
+				tfRecurrence["start"] = start.Format("2006-01-02T15:04:05")
+			}
+			recurrences = append(recurrences, tfRecurrence)
+		}
+		recurringSchedule := map[string]interface{}{
+			"recurrence": recurrences,
+			"timezone":   schedule.Timezone,
+		}
+		if err := d.Set("recurring_schedule", []interface{}{recurringSchedule}); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("one_time_schedule", nil); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if schedule != nil {
+		oneTimeSchedule := map[string]interface{}{
+			"start": schedule.Start,
+		}
+		if schedule.End != nil {
+			oneTimeSchedule["end"] = *schedule.End
+		}
+		if err := d.Set("one_time_schedule", []interface{}{oneTimeSchedule}); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("recurring_schedule", nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := d.Set("display_timezone", attributes.DisplayTimezone); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", attributes.Message); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mute_first_recovery_notification", attributes.MuteFirstRecoveryNotification); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("notify_end_states", attributes.NotifyEndStates); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("notify_end_types", attributes.NotifyEndTypes); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -56,7 +56,7 @@ func buildDatadogIntegrationAwsLogCollectionStruct(d *schema.ResourceData) *data
 func resourceDatadogIntegrationAwsLogCollectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	// shared with datadog_integration_aws resource
 	integrationAwsMutex.Lock()
@@ -82,7 +82,7 @@ func resourceDatadogIntegrationAwsLogCollectionCreate(ctx context.Context, d *sc
 func resourceDatadogIntegrationAwsLogCollectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	// shared with datadog_integration_aws resource
 	integrationAwsMutex.Lock()
@@ -100,7 +100,7 @@ func resourceDatadogIntegrationAwsLogCollectionUpdate(ctx context.Context, d *sc
 func resourceDatadogIntegrationAwsLogCollectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	accountID := d.Id()
 
@@ -126,7 +126,7 @@ func resourceDatadogIntegrationAwsLogCollectionRead(ctx context.Context, d *sche
 func resourceDatadogIntegrationAwsLogCollectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	// shared with datadog_integration_aws resource
 	integrationAwsMutex.Lock()
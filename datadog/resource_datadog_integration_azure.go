@@ -60,7 +60,7 @@ func resourceDatadogIntegrationAzure() *schema.Resource {
 func resourceDatadogIntegrationAzureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	tenantName, clientId, err := utils.TenantAndClientFromID(d.Id())
 	if err != nil {
@@ -92,7 +92,7 @@ func resourceDatadogIntegrationAzureRead(ctx context.Context, d *schema.Resource
 func resourceDatadogIntegrationAzureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationAzureMutex.Lock()
 	defer integrationAzureMutex.Unlock()
@@ -114,7 +114,7 @@ func resourceDatadogIntegrationAzureCreate(ctx context.Context, d *schema.Resour
 func resourceDatadogIntegrationAzureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationAzureMutex.Lock()
 	defer integrationAzureMutex.Unlock()
@@ -138,7 +138,7 @@ func resourceDatadogIntegrationAzureUpdate(ctx context.Context, d *schema.Resour
 func resourceDatadogIntegrationAzureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationAzureMutex.Lock()
 	defer integrationAzureMutex.Unlock()
@@ -89,7 +89,7 @@ const (
 func resourceDatadogIntegrationGcpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationGcpMutex.Lock()
 	defer integrationGcpMutex.Unlock()
@@ -124,7 +124,7 @@ func resourceDatadogIntegrationGcpCreate(ctx context.Context, d *schema.Resource
 func resourceDatadogIntegrationGcpRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	projectID := d.Id()
 
@@ -152,7 +152,7 @@ func resourceDatadogIntegrationGcpRead(ctx context.Context, d *schema.ResourceDa
 func resourceDatadogIntegrationGcpUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationGcpMutex.Lock()
 	defer integrationGcpMutex.Unlock()
@@ -175,7 +175,7 @@ func resourceDatadogIntegrationGcpUpdate(ctx context.Context, d *schema.Resource
 func resourceDatadogIntegrationGcpDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationGcpMutex.Lock()
 	defer integrationGcpMutex.Unlock()
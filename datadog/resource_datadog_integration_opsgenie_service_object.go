@@ -100,7 +100,7 @@ func updateOpsgenieServiceState(d *schema.ResourceData, serviceData *datadogV2.O
 func resourceDatadogIntegrationOpsgenieServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetOpsgenieIntegrationApiV2().CreateOpsgenieService(auth, *buildOpsgenieServiceCreateRequest(d))
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceDatadogIntegrationOpsgenieServiceCreate(ctx context.Context, d *sch
 func resourceDatadogIntegrationOpsgenieServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetOpsgenieIntegrationApiV2().GetOpsgenieService(auth, d.Id())
 	if err != nil {
@@ -133,7 +133,7 @@ func resourceDatadogIntegrationOpsgenieServiceRead(ctx context.Context, d *schem
 func resourceDatadogIntegrationOpsgenieServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetOpsgenieIntegrationApiV2().UpdateOpsgenieService(auth, d.Id(), *buildOpsgenieServiceUpdateRequest(d))
 	if err != nil {
@@ -146,7 +146,7 @@ func resourceDatadogIntegrationOpsgenieServiceUpdate(ctx context.Context, d *sch
 func resourceDatadogIntegrationOpsgenieServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetOpsgenieIntegrationApiV2().DeleteOpsgenieService(auth, d.Id()); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error deleting Opsgenie service")
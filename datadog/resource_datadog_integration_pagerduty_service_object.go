@@ -63,7 +63,7 @@ func buildIntegrationPagerdutyServiceKey(d *schema.ResourceData) *datadogV1.Page
 func resourceDatadogIntegrationPagerdutySOCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationPdMutex.Lock()
 	defer integrationPdMutex.Unlock()
@@ -81,7 +81,7 @@ func resourceDatadogIntegrationPagerdutySOCreate(ctx context.Context, d *schema.
 func resourceDatadogIntegrationPagerdutySORead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	so, httpresp, err := apiInstances.GetPagerDutyIntegrationApiV1().GetPagerDutyIntegrationService(auth, d.Id())
 	if err != nil {
@@ -108,7 +108,7 @@ func resourceDatadogIntegrationPagerdutySORead(ctx context.Context, d *schema.Re
 func resourceDatadogIntegrationPagerdutySOUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationPdMutex.Lock()
 	defer integrationPdMutex.Unlock()
@@ -124,7 +124,7 @@ func resourceDatadogIntegrationPagerdutySOUpdate(ctx context.Context, d *schema.
 func resourceDatadogIntegrationPagerdutySODelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationPdMutex.Lock()
 	defer integrationPdMutex.Unlock()
@@ -100,7 +100,7 @@ func buildDatadogSlackChannel(d *schema.ResourceData) *datadogV1.SlackIntegratio
 func resourceDatadogIntegrationSlackChannelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationSlackChannelMutex.Lock()
 	defer integrationSlackChannelMutex.Unlock()
@@ -123,7 +123,7 @@ func resourceDatadogIntegrationSlackChannelCreate(ctx context.Context, d *schema
 func resourceDatadogIntegrationSlackChannelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	accountName, channelName, err := utils.AccountNameAndChannelNameFromID(d.Id())
 	if err != nil {
@@ -148,7 +148,7 @@ func resourceDatadogIntegrationSlackChannelRead(ctx context.Context, d *schema.R
 func resourceDatadogIntegrationSlackChannelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationSlackChannelMutex.Lock()
 	defer integrationSlackChannelMutex.Unlock()
@@ -176,7 +176,7 @@ func resourceDatadogIntegrationSlackChannelUpdate(ctx context.Context, d *schema
 func resourceDatadogIntegrationSlackChannelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	integrationSlackChannelMutex.Lock()
 	defer integrationSlackChannelMutex.Unlock()
@@ -41,7 +41,7 @@ func resourceDatadogLogsArchiveOrderCreate(ctx context.Context, d *schema.Resour
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if len(ddArchiveList.Data.Attributes.GetArchiveIds()) > 0 {
 		return resourceDatadogLogsArchiveOrderUpdate(ctx, d, meta)
@@ -65,7 +65,7 @@ func resourceDatadogLogsArchiveOrderCreate(ctx context.Context, d *schema.Resour
 func resourceDatadogLogsArchiveOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	order, httpResponse, err := apiInstances.GetLogsArchivesApiV2().GetLogsArchiveOrder(auth)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error getting logs archive order")
@@ -92,7 +92,7 @@ func resourceDatadogLogsArchiveOrderUpdate(ctx context.Context, d *schema.Resour
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	updatedOrder, httpResponse, err := apiInstances.GetLogsArchivesApiV2().UpdateLogsArchiveOrder(auth, *ddArchiveList)
 	if err != nil {
 		// Cannot map archives to existing ones
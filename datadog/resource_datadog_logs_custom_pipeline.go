@@ -362,7 +362,7 @@ func resourceDatadogLogsCustomPipeline() *schema.Resource {
 func resourceDatadogLogsPipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logCustomPipelineMutex.Lock()
 	defer logCustomPipelineMutex.Unlock()
@@ -405,7 +405,7 @@ func updateLogsCustomPipelineState(d *schema.ResourceData, pipeline *datadogV1.L
 func resourceDatadogLogsPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ddPipeline, httpresp, err := apiInstances.GetLogsPipelinesApiV1().GetLogsPipeline(auth, d.Id())
 	if err != nil {
@@ -424,7 +424,7 @@ func resourceDatadogLogsPipelineRead(ctx context.Context, d *schema.ResourceData
 func resourceDatadogLogsPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logCustomPipelineMutex.Lock()
 	defer logCustomPipelineMutex.Unlock()
@@ -446,7 +446,7 @@ func resourceDatadogLogsPipelineUpdate(ctx context.Context, d *schema.ResourceDa
 func resourceDatadogLogsPipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logCustomPipelineMutex.Lock()
 	defer logCustomPipelineMutex.Unlock()
@@ -119,7 +119,7 @@ func resourceDatadogLogsIndex() *schema.Resource {
 func resourceDatadogLogsIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logsIndexMutex.Lock()
 	defer logsIndexMutex.Unlock()
@@ -162,7 +162,7 @@ func updateLogsIndexState(d *schema.ResourceData, index *datadogV1.LogsIndex) di
 func resourceDatadogLogsIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ddIndex, httpresp, err := apiInstances.GetLogsIndexesApiV1().GetLogsIndex(auth, d.Id())
 	if err != nil {
@@ -181,7 +181,7 @@ func resourceDatadogLogsIndexRead(ctx context.Context, d *schema.ResourceData, m
 func resourceDatadogLogsIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	logsIndexMutex.Lock()
 	defer logsIndexMutex.Unlock()
@@ -44,7 +44,7 @@ func updateLogsIntegrationPipelineState(d *schema.ResourceData, pipeline *datado
 func resourceDatadogLogsIntegrationPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	ddPipeline, httpresp, err := apiInstances.GetLogsPipelinesApiV1().
 		GetLogsPipeline(auth, d.Id())
 	if err != nil {
@@ -69,7 +69,7 @@ func resourceDatadogLogsIntegrationPipelineUpdate(ctx context.Context, d *schema
 	ddPipeline.SetIsEnabled(d.Get("is_enabled").(bool))
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	updatedPipeline, httpResponse, err := apiInstances.GetLogsPipelinesApiV1().
 		UpdateLogsPipeline(auth, d.Id(), ddPipeline)
 	if err != nil {
@@ -51,7 +51,7 @@ func updateLogsPipelineOrderState(d *schema.ResourceData, order *datadogV1.LogsP
 func resourceDatadogLogsPipelineOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	order, httpResponse, err := apiInstances.GetLogsPipelinesApiV1().
 		GetLogsPipelineOrder(auth)
 	if err != nil {
@@ -78,7 +78,7 @@ func resourceDatadogLogsPipelineOrderUpdate(ctx context.Context, d *schema.Resou
 	}
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	updatedOrder, httpResponse, err := apiInstances.GetLogsPipelinesApiV1().
 		UpdateLogsPipelineOrder(auth, ddPipelineList)
 	if err != nil {
@@ -76,7 +76,7 @@ func buildMetricMetadataStruct(d *schema.ResourceData) (string, *datadogV1.Metri
 func resourceDatadogMetricMetadataCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id, m := buildMetricMetadataStruct(d)
 	createdMetadata, httpResponse, err := apiInstances.GetMetricsApiV1().UpdateMetricMetadata(auth, id, *m)
@@ -118,7 +118,7 @@ func updateMetricMetadataState(d *schema.ResourceData, metadata *datadogV1.Metri
 func resourceDatadogMetricMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 
@@ -139,7 +139,7 @@ func resourceDatadogMetricMetadataRead(ctx context.Context, d *schema.ResourceDa
 func resourceDatadogMetricMetadataUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	m := &datadogV1.MetricMetadata{}
 	id := d.Get("metric").(string)
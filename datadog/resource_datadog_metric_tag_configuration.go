@@ -235,7 +235,7 @@ func buildDatadogMetricTagConfigurationUpdate(d *schema.ResourceData, existingMe
 func resourceDatadogMetricTagConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resultMetricTagConfigurationData, err := buildDatadogMetricTagConfiguration(d)
 	if err != nil {
@@ -305,7 +305,7 @@ func updateMetricTagConfigurationState(d *schema.ResourceData, metricTagConfigur
 func resourceDatadogMetricTagConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	metricName := d.Id()
 	metricTagConfigurationResponse, httpresp, err := apiInstances.GetMetricsApiV2().ListTagConfigurationByName(auth, metricName)
@@ -333,7 +333,7 @@ func resourceDatadogMetricTagConfigurationRead(ctx context.Context, d *schema.Re
 func resourceDatadogMetricTagConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	metricName := d.Id()
 	metricTagConfigurationResponse, httpresp, err := apiInstances.GetMetricsApiV2().ListTagConfigurationByName(auth, metricName)
@@ -374,7 +374,7 @@ func resourceDatadogMetricTagConfigurationUpdate(ctx context.Context, d *schema.
 func resourceDatadogMetricTagConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	var err error
 
 	metricName := d.Id()
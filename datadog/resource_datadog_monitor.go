@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -34,7 +35,7 @@ func resourceDatadogMonitor() *schema.Resource {
 		DeleteContext: resourceDatadogMonitorDelete,
 		CustomizeDiff: resourceDatadogMonitorCustomizeDiff,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceDatadogMonitorImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -43,17 +44,21 @@ func resourceDatadogMonitor() *schema.Resource {
 				Required:    true,
 			},
 			"message": {
-				Description: "A message to include with notifications for this monitor.\n\nEmail notifications can be sent to specific users by using the same `@username` notation as events.",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "A message to include with notifications for this monitor.\n\nEmail notifications can be sent to specific users by using the same `@username` notation as events. Exactly one of `message` or `notification` must be set, `notification` is rendered into this field.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"message", "notification"},
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSpace(val.(string))
 				},
 			},
+			"notification": getMonitorNotificationSchema(),
 			"escalation_message": {
-				Description: "A message to include with a re-notification. Supports the `@username` notification allowed elsewhere.",
+				Description: "A message to include with a re-notification. Supports the `@username` notification allowed elsewhere. Computed from `notification` when its `escalation` block is set.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				StateFunc: func(val interface{}) string {
 					return strings.TrimSpace(val.(string))
 				},
@@ -296,6 +301,7 @@ func resourceDatadogMonitor() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"tags_all": getTagsAllSchema(),
 			"groupby_simple_monitor": {
 				Description: "Whether or not to trigger one alert if any source breaches a threshold. This is only used by log monitors. Defaults to `false`.",
 				Type:        schema.TypeBool,
@@ -318,6 +324,7 @@ func resourceDatadogMonitor() *schema.Resource {
 			"enable_samples": {
 				Description: "Whether or not a list of samples which triggered the alert is included. This is only used by CI Test and Pipeline monitors.",
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
 			},
 			"force_delete": {
@@ -326,7 +333,7 @@ func resourceDatadogMonitor() *schema.Resource {
 				Optional:    true,
 			},
 			"validate": {
-				Description: "If set to `false`, skip the validation call done during plan.",
+				Description: "If set to `false`, skip the validation call done during plan. The thresholds and options are still checked locally.",
 				Type:        schema.TypeBool,
 				Optional:    true,
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -505,6 +512,263 @@ func getMonitorFormulaQuerySchema() *schema.Schema {
 	}
 }
 
+func getMonitorNotificationSectionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"text": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The free text of the section. It can reference template variables, e.g. `{{value}}` or `{{host.name}}`.",
+				},
+				"recipients": getMonitorNotificationRecipientsSchema("The handles to notify, e.g. `@slack-account-channel` or `@user@example.com`."),
+			},
+		},
+	}
+}
+
+func getMonitorNotificationRecipientsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^@\S+$`), "must be a notification handle starting with `@`"),
+		},
+	}
+}
+
+func getMonitorNotificationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "A structured definition of the monitor notification, rendered into the monitor message and escalation message. Conditional sections such as `{{#is_alert}}` are rendered from the transition blocks and can't be used in texts. Slack, PagerDuty, Opsgenie and webhook handles are checked against the Datadog integrations during plan unless `validate` is set to `false`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"header": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The free text rendered first in every notification. It can reference template variables, e.g. `{{value}}` or `{{host.name}}`.",
+				},
+				"recipients": getMonitorNotificationRecipientsSchema("The handles to notify on every transition, e.g. `@slack-account-channel` or `@user@example.com`."),
+				"alert":      getMonitorNotificationSectionSchema("The section rendered when the monitor triggers an alert."),
+				"warning":    getMonitorNotificationSectionSchema("The section rendered when the monitor triggers a warning."),
+				"no_data":    getMonitorNotificationSectionSchema("The section rendered when the monitor is in no data state."),
+				"recovery":   getMonitorNotificationSectionSchema("The section rendered when the monitor recovers."),
+				"footer": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The free text rendered last in every notification.",
+				},
+				"escalation": getMonitorNotificationSectionSchema("The section rendered into the escalation message, sent on re-notifications."),
+			},
+		},
+	}
+}
+
+func buildMonitorNotificationSection(v interface{}) *utils.MonitorNotificationSection {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 {
+		return nil
+	}
+	section := &utils.MonitorNotificationSection{}
+	if m, ok := l[0].(map[string]interface{}); ok {
+		section.Text, _ = m["text"].(string)
+		section.Recipients = buildMonitorNotificationRecipients(m["recipients"])
+	}
+	return section
+}
+
+func buildMonitorNotificationRecipients(v interface{}) []string {
+	l, _ := v.([]interface{})
+	recipients := make([]string, 0, len(l))
+	for _, recipient := range l {
+		if recipient, ok := recipient.(string); ok && recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+	return recipients
+}
+
+// buildMonitorNotification returns the notification block of a monitor, or nil if it isn't set
+func buildMonitorNotification(d utils.Resource) *utils.MonitorNotification {
+	l, ok := d.Get("notification").([]interface{})
+	if !ok || len(l) == 0 {
+		return nil
+	}
+	notification := &utils.MonitorNotification{}
+	if m, ok := l[0].(map[string]interface{}); ok {
+		notification.Header, _ = m["header"].(string)
+		notification.Recipients = buildMonitorNotificationRecipients(m["recipients"])
+		notification.Alert = buildMonitorNotificationSection(m["alert"])
+		notification.Warning = buildMonitorNotificationSection(m["warning"])
+		notification.NoData = buildMonitorNotificationSection(m["no_data"])
+		notification.Recovery = buildMonitorNotificationSection(m["recovery"])
+		notification.Footer, _ = m["footer"].(string)
+		notification.Escalation = buildMonitorNotificationSection(m["escalation"])
+	}
+	return notification
+}
+
+// renderMonitorNotification renders the notification block of a monitor and checks its handles. It returns
+// false if the block isn't set or isn't known yet.
+func renderMonitorNotification(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) (string, string, bool, error) {
+	if rawPlan := diff.GetRawPlan(); !rawPlan.IsNull() && !rawPlan.GetAttr("notification").IsWhollyKnown() {
+		return "", "", false, nil
+	}
+	notification := buildMonitorNotification(diff)
+	if notification == nil {
+		return "", "", false, nil
+	}
+	message, err := notification.Message()
+	if err != nil {
+		return "", "", false, err
+	}
+	escalationMessage, err := notification.EscalationMessage()
+	if err != nil {
+		return "", "", false, err
+	}
+	if validate, ok := diff.GetOkExists("validate"); !ok || validate.(bool) {
+		if err := validateMonitorNotificationHandles(ctx, meta, notification.Handles()); err != nil {
+			return "", "", false, err
+		}
+	}
+	return message, escalationMessage, true, nil
+}
+
+// customizeDiffMonitorNotification renders the notification block into `message` and `escalation_message`
+func customizeDiffMonitorNotification(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	escalationMessageConfigured := !rawConfig.IsNull() && !rawConfig.GetAttr("escalation_message").IsNull()
+	if _, ok := diff.GetOk("notification"); !ok {
+		// escalation_message is only computed from the notification block, clear it when it's removed
+		if !rawConfig.IsNull() && !escalationMessageConfigured && diff.Get("escalation_message").(string) != "" {
+			return diff.SetNew("escalation_message", "")
+		}
+		return nil
+	}
+	if escalationMessageConfigured && len(diff.Get("notification.0.escalation").([]interface{})) > 0 {
+		return fmt.Errorf("`escalation_message` conflicts with `notification.0.escalation`")
+	}
+
+	message, escalationMessage, ok, err := renderMonitorNotification(ctx, diff, meta)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		if err := diff.SetNewComputed("message"); err != nil {
+			return err
+		}
+		if !escalationMessageConfigured {
+			return diff.SetNewComputed("escalation_message")
+		}
+		return nil
+	}
+	if diff.Get("message").(string) != message {
+		if err := diff.SetNew("message", message); err != nil {
+			return err
+		}
+	}
+	if !escalationMessageConfigured && diff.Get("escalation_message").(string) != escalationMessage {
+		return diff.SetNew("escalation_message", escalationMessage)
+	}
+	return nil
+}
+
+// validateMonitorNotificationHandles checks that the integration handles reference existing Slack channels,
+// PagerDuty services, Opsgenie services and webhooks. The handles that can't be checked, e.g. because of
+// missing permissions or a Slack handle without account, are accepted.
+func validateMonitorNotificationHandles(ctx context.Context, meta interface{}, handles []string) error {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	slackChannels := make(map[string]map[string]bool)
+	getSlackChannels := func(account string) map[string]bool {
+		if channels, ok := slackChannels[account]; ok {
+			return channels
+		}
+		var channels map[string]bool
+		resp, httpresp, err := apiInstances.GetSlackIntegrationApiV1().GetSlackIntegrationChannels(auth, account)
+		if err == nil {
+			channels = make(map[string]bool)
+			for _, channel := range resp {
+				channels[strings.TrimPrefix(channel.GetName(), "#")] = true
+			}
+		} else if httpresp == nil || httpresp.StatusCode != 404 {
+			log.Printf("[DEBUG] Couldn't get the channels of Slack account %s: %v", account, err)
+		}
+		slackChannels[account] = channels
+		return channels
+	}
+	var opsgenieServices map[string]bool
+	opsgenieListed := false
+
+	var errs []string
+	for _, handle := range handles {
+		integration, name := utils.ParseNotificationHandle(handle)
+		switch integration {
+		case "slack":
+			// Account names can contain dashes, try every account and channel split
+			var accounts []string
+			found := false
+			for i := 0; i < len(name) && !found; i++ {
+				if name[i] != '-' {
+					continue
+				}
+				if channels := getSlackChannels(name[:i]); channels != nil {
+					accounts = append(accounts, name[:i])
+					found = channels[name[i+1:]]
+				}
+			}
+			if !found && len(accounts) > 0 {
+				errs = append(errs, fmt.Sprintf("%s: channel not found in Slack account %s", handle, strings.Join(accounts, ", ")))
+			}
+		case "pagerduty":
+			if _, httpresp, err := apiInstances.GetPagerDutyIntegrationApiV1().GetPagerDutyIntegrationService(auth, name); err != nil {
+				if httpresp != nil && httpresp.StatusCode == 404 {
+					errs = append(errs, fmt.Sprintf("%s: PagerDuty service %s not found", handle, name))
+				} else {
+					log.Printf("[DEBUG] Couldn't get PagerDuty service %s: %v", name, err)
+				}
+			}
+		case "opsgenie":
+			if !opsgenieListed {
+				opsgenieListed = true
+				if resp, _, err := apiInstances.GetOpsgenieIntegrationApiV2().ListOpsgenieServices(auth); err == nil {
+					opsgenieServices = make(map[string]bool)
+					for _, service := range resp.GetData() {
+						opsgenieServices[service.Attributes.GetName()] = true
+					}
+				} else {
+					log.Printf("[DEBUG] Couldn't list Opsgenie services: %v", err)
+				}
+			}
+			if opsgenieServices != nil && !opsgenieServices[name] {
+				errs = append(errs, fmt.Sprintf("%s: Opsgenie service %s not found", handle, name))
+			}
+		case "webhook":
+			if _, httpresp, err := apiInstances.GetWebhooksIntegrationApiV1().GetWebhooksIntegration(auth, name); err != nil {
+				if httpresp != nil && httpresp.StatusCode == 404 {
+					errs = append(errs, fmt.Sprintf("%s: webhook %s not found", handle, name))
+				} else {
+					log.Printf("[DEBUG] Couldn't get webhook %s: %v", name, err)
+				}
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid notification handles:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
 func buildMonitorStruct(d utils.Resource) (*datadogV1.Monitor, *datadogV1.MonitorUpdateRequest) {
 
 	var thresholds datadogV1.MonitorThresholds
@@ -715,6 +979,13 @@ func buildMonitorStruct(d utils.Resource) (*datadogV1.Monitor, *datadogV1.Monito
 	return m, u
 }
 
+// mergeMonitorDefaultTags adds the provider default tags to the monitor tags, keeping them sorted
+func mergeMonitorDefaultTags(meta interface{}, tags []string) []string {
+	tags = mergeDefaultTags(meta, tags)
+	sort.Strings(tags)
+	return tags
+}
+
 func buildMonitorFormulaAndFunctionEventQuery(data map[string]interface{}) datadogV1.MonitorFormulaAndFunctionQueryDefinition {
 	dataSource := datadogV1.MonitorFormulaAndFunctionEventsDataSource(data["data_source"].(string))
 	computeList := data["compute"].([]interface{})
@@ -786,6 +1057,15 @@ func buildMonitorFormulaAndFunctionEventQuery(data map[string]interface{}) datad
 
 // Use CustomizeDiff to do monitor validation
 func resourceDatadogMonitorCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateMonitorDefinitionDiff(diff); err != nil {
+		return err
+	}
+	if err := customizeDiffTagsAll(ctx, diff, meta); err != nil {
+		return err
+	}
+	if err := customizeDiffMonitorNotification(ctx, diff, meta); err != nil {
+		return err
+	}
 	if _, ok := diff.GetOk("query"); !ok {
 		// If "query" depends on other resources, we can't validate as the variables may not be interpolated yet.
 		return nil
@@ -799,6 +1079,7 @@ func resourceDatadogMonitorCustomizeDiff(ctx context.Context, diff *schema.Resou
 		return nil
 	}
 	m, _ := buildMonitorStruct(diff)
+	m.SetTags(mergeMonitorDefaultTags(meta, m.GetTags()))
 
 	hasID := false
 	id, err := strconv.ParseInt(diff.Id(), 10, 64)
@@ -808,7 +1089,7 @@ func resourceDatadogMonitorCustomizeDiff(ctx context.Context, diff *schema.Resou
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	return resource.RetryContext(ctx, retryTimeout, func() *resource.RetryError {
 		var httpresp *http.Response
 		if hasID {
@@ -826,12 +1107,58 @@ func resourceDatadogMonitorCustomizeDiff(ctx context.Context, diff *schema.Resou
 	})
 }
 
+// validateMonitorDefinitionDiff checks the monitor definition locally, so it also runs with `validate = false`
+// and before the values depending on other resources are known
+func validateMonitorDefinitionDiff(diff *schema.ResourceDiff) error {
+	definition := utils.MonitorDefinition{Thresholds: make(map[string]float64)}
+	if diff.NewValueKnown("type") {
+		definition.Type = diff.Get("type").(string)
+	}
+	if diff.NewValueKnown("query") {
+		definition.Query = diff.Get("query").(string)
+	}
+	for _, k := range []string{"ok", "warning", "critical", "unknown", "warning_recovery", "critical_recovery"} {
+		if r, ok := diff.GetOk("monitor_thresholds.0." + k); ok {
+			if v, err := json.Number(r.(string)).Float64(); err == nil {
+				definition.Thresholds[k] = v
+			}
+		}
+	}
+	if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+		for _, option := range []string{"enable_logs_sample", "groupby_simple_monitor", "enable_samples", "new_group_delay", "monitor_threshold_windows"} {
+			v := rawConfig.GetAttr(option)
+			// Absent blocks are empty lists
+			if v.IsNull() || (v.IsKnown() && v.Type().IsListType() && v.LengthInt() == 0) {
+				continue
+			}
+			definition.Options = append(definition.Options, option)
+		}
+	}
+	if diff.NewValueKnown("notify_by") {
+		for _, tag := range diff.Get("notify_by").(*schema.Set).List() {
+			definition.NotifyBy = append(definition.NotifyBy, tag.(string))
+		}
+	}
+	_, definition.HasVariables = diff.GetOk("variables")
+
+	errs := utils.ValidateMonitorDefinition(definition)
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Errorf("invalid monitor definition: %s", strings.Join(messages, "; "))
+}
+
 func resourceDatadogMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	m, _ := buildMonitorStruct(d)
+	m.SetTags(mergeMonitorDefaultTags(meta, m.GetTags()))
 	mCreated, httpResponse, err := apiInstances.GetMonitorsApiV1().CreateMonitor(auth, *m)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error creating monitor")
@@ -957,7 +1284,7 @@ func updateMonitorState(d *schema.ResourceData, meta interface{}, m *datadogV1.M
 	var tags []string
 	tags = append(tags, m.GetTags()...)
 	sort.Strings(tags)
-	if err := d.Set("tags", tags); err != nil {
+	if err := setTagsState(d, meta, tags); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("require_full_window", m.Options.GetRequireFullWindow()); err != nil {
@@ -1102,7 +1429,7 @@ func buildTerraformMonitorVariables(datadogVariables []datadogV1.MonitorFormulaA
 func resourceDatadogMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	i, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -1143,9 +1470,10 @@ func resourceDatadogMonitorRead(ctx context.Context, d *schema.ResourceData, met
 func resourceDatadogMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	_, m := buildMonitorStruct(d)
+	m.SetTags(mergeMonitorDefaultTags(meta, m.GetTags()))
 	i, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
 		return diag.FromErr(err)
@@ -1167,7 +1495,7 @@ func resourceDatadogMonitorUpdate(ctx context.Context, d *schema.ResourceData, m
 func resourceDatadogMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	i, err := strconv.ParseInt(d.Id(), 10, 64)
 	if err != nil {
@@ -1190,6 +1518,63 @@ func resourceDatadogMonitorDelete(ctx context.Context, d *schema.ResourceData, m
 	return nil
 }
 
+// resourceDatadogMonitorImport accepts a monitor ID, `name=<exact name>` or `query=<monitor search query>`
+func resourceDatadogMonitorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	key, value := utils.ParseImportFilter(d.Id())
+	if key == "" {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	matches := make(map[string]string)
+	switch key {
+	case "name":
+		// The name filter matches substrings, only keep exact matches
+		pageSize := int32(1000)
+		for page := int64(0); ; page++ {
+			optionalParams := datadogV1.NewListMonitorsOptionalParameters().WithName(value).WithPage(page).WithPageSize(pageSize)
+			monitors, httpresp, err := apiInstances.GetMonitorsApiV1().ListMonitors(auth, *optionalParams)
+			if err != nil {
+				return nil, utils.TranslateClientError(err, httpresp, "error querying monitors")
+			}
+			for _, m := range monitors {
+				if m.GetName() == value {
+					matches[strconv.FormatInt(m.GetId(), 10)] = m.GetName()
+				}
+			}
+			if len(monitors) < int(pageSize) {
+				break
+			}
+		}
+	case "query":
+		for page := int64(0); ; page++ {
+			optionalParams := datadogV1.NewSearchMonitorsOptionalParameters().WithQuery(value).WithPage(page).WithPerPage(1000)
+			resp, httpresp, err := apiInstances.GetMonitorsApiV1().SearchMonitors(auth, *optionalParams)
+			if err != nil {
+				return nil, utils.TranslateClientError(err, httpresp, "error searching monitors")
+			}
+			for _, m := range resp.GetMonitors() {
+				matches[strconv.FormatInt(m.GetId(), 10)] = m.GetName()
+			}
+			if page+1 >= resp.Metadata.GetPageCount() {
+				break
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported import ID %q, expected a monitor ID, `name=<name>` or `query=<search query>`", d.Id())
+	}
+
+	id, err := utils.UniqueImportMatch("monitor", d.Id(), matches)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(id)
+	return []*schema.ResourceData{d}, nil
+}
+
 // Ignore any diff that results from the mix of ints or floats returned from the
 // DataDog API.
 func suppressDataDogFloatIntDiff(_, old, new string, _ *schema.ResourceData) bool {
@@ -126,7 +126,7 @@ func buildUpdateRequestPolicy(d *schema.ResourceData, policyType datadogV2.Monit
 func resourceDatadogMonitorConfigPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	var monitorConfigPolicyResponse datadogV2.MonitorConfigPolicyResponse
 	monitorConfigPolicyResponse, httpresp, err := apiInstances.GetMonitorsApiV2().GetMonitorConfigPolicy(auth, d.Id())
@@ -144,7 +144,7 @@ func resourceDatadogMonitorConfigPolicyRead(ctx context.Context, d *schema.Resou
 func resourceDatadogMonitorConfigPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	err := checkPolicyConsistency(d)
 	if err != nil {
 		return diag.FromErr(err)
@@ -166,7 +166,7 @@ func resourceDatadogMonitorConfigPolicyCreate(ctx context.Context, d *schema.Res
 func resourceDatadogMonitorConfigPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	err := checkPolicyConsistency(d)
 	if err != nil {
@@ -191,7 +191,7 @@ func resourceDatadogMonitorConfigPolicyUpdate(ctx context.Context, d *schema.Res
 func resourceDatadogMonitorConfigPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	httpresp, err := apiInstances.GetMonitorsApiV2().DeleteMonitorConfigPolicy(auth, d.Id())
 	if err != nil {
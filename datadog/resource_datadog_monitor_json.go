@@ -47,54 +47,81 @@ func resourceDatadogMonitorJSON() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
-		CustomizeDiff: customdiff.ForceNewIfChange("monitor", func(ctx context.Context, old, new, meta interface{}) bool {
-			oldAttrMap, _ := structure.ExpandJsonFromString(old.(string))
-			newAttrMap, _ := structure.ExpandJsonFromString(new.(string))
-
-			oldType, ok := oldAttrMap["type"].(string)
-			if !ok {
-				return true
-			}
-
-			newType, ok := newAttrMap["type"].(string)
-			if !ok {
-				return true
-			}
-
-			return oldType != newType
-		}),
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("monitor", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldAttrMap, _ := structure.ExpandJsonFromString(old.(string))
+				newAttrMap, _ := structure.ExpandJsonFromString(new.(string))
+
+				oldType, ok := oldAttrMap["type"].(string)
+				if !ok {
+					return true
+				}
+
+				newType, ok := newAttrMap["type"].(string)
+				if !ok {
+					return true
+				}
+
+				return oldType != newType
+			}),
+			func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				// Only check the notification block, it's rendered into the definition on create and update
+				_, _, _, err := renderMonitorNotification(ctx, diff, meta)
+				return err
+			},
+			customizeDiffMonitorJSONFields,
+		),
 		Schema: map[string]*schema.Schema{
 			"monitor": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ValidateFunc: validation.StringIsJSON,
 				StateFunc: func(v interface{}) string {
-					// Remove computed fields when comparing diffs
+					// Normalize the definition when comparing diffs
 					attrMap, _ := structure.ExpandJsonFromString(v.(string))
-					for _, f := range monitorComputedFields {
-						utils.DeleteKeyInMap(attrMap, strings.Split(f, "."))
+					normalizeMonitorJSON(attrMap)
+					res, _ := structure.FlattenJsonToString(attrMap)
+					return res
+				},
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					notification := buildMonitorNotification(d)
+					if notification == nil {
+						return false
 					}
-					if name, ok := attrMap["name"]; ok {
-						if name, ok := name.(string); ok {
-							attrMap["name"] = strings.TrimSpace(name)
-						}
+					// The messages are rendered from the notification block, ignore them
+					oldAttrMap, err := structure.ExpandJsonFromString(old)
+					if err != nil {
+						return false
 					}
-					if msg, ok := attrMap["message"]; ok {
-						if msg, ok := msg.(string); ok {
-							attrMap["message"] = strings.TrimSpace(msg)
-						}
+					newAttrMap, err := structure.ExpandJsonFromString(new)
+					if err != nil {
+						return false
 					}
-
-					// restricted_roles is a special case and exporting the field from UI does not include this field. But the api
-					// returns a `null` value on creation. If null we remove the field from state to avoid unnecessary diffs.
-					if val := reflect.ValueOf(attrMap["restricted_roles"]); !val.IsValid() {
-						utils.DeleteKeyInMap(attrMap, []string{"restricted_roles"})
+					for _, attrMap := range []map[string]interface{}{oldAttrMap, newAttrMap} {
+						utils.DeleteKeyInMap(attrMap, []string{"message"})
+						if notification.Escalation != nil {
+							utils.DeleteKeyInMap(attrMap, []string{"options", "escalation_message"})
+						}
 					}
-
-					res, _ := structure.FlattenJsonToString(attrMap)
-					return res
+					return reflect.DeepEqual(oldAttrMap, newAttrMap)
+				},
+				Description: "The JSON formatted definition of the monitor. When `notification` is set, the `message` field is rendered from it, as well as `options.escalation_message` if its `escalation` block is set.",
+			},
+			"monitor_fields": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The JSON encoded fields of the normalized monitor definition by JSON pointer, e.g. `/options/thresholds/critical`, so that plans show which fields change.",
+			},
+			"notification": getMonitorNotificationSchema(),
+			"validate": {
+				Description: "If set to `false`, skip the validation of the `notification` handles done during plan.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					// This is never sent to the backend, so it should never generate a diff
+					return true
 				},
-				Description: "The JSON formatted definition of the monitor.",
 			},
 			"url": {
 				Type:        schema.TypeString,
@@ -106,10 +133,10 @@ func resourceDatadogMonitorJSON() *schema.Resource {
 	}
 }
 
-func resourceDatadogMonitorJSONRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogMonitorJSONRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 	respByte, httpResp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", monitorPath+"/"+id, nil)
@@ -132,9 +159,12 @@ func resourceDatadogMonitorJSONRead(_ context.Context, d *schema.ResourceData, m
 func resourceDatadogMonitorJSONCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
-	monitor := d.Get("monitor").(string)
+	monitor, err := buildMonitorJSONDefinition(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
 	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "POST", monitorPath, &monitor)
 	if err != nil {
@@ -156,12 +186,15 @@ func resourceDatadogMonitorJSONCreate(ctx context.Context, d *schema.ResourceDat
 	return updateMonitorJSONState(d, respMap)
 }
 
-func resourceDatadogMonitorJSONUpdate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogMonitorJSONUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
-	monitor := d.Get("monitor").(string)
+	monitor, err := buildMonitorJSONDefinition(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 	id := d.Id()
 
 	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "PUT", monitorPath+"/"+id, &monitor)
@@ -177,10 +210,10 @@ func resourceDatadogMonitorJSONUpdate(_ context.Context, d *schema.ResourceData,
 	return updateMonitorJSONState(d, respMap)
 }
 
-func resourceDatadogMonitorJSONDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogMonitorJSONDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 
@@ -192,6 +225,36 @@ func resourceDatadogMonitorJSONDelete(_ context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// buildMonitorJSONDefinition returns the monitor definition, with the messages rendered from the notification block
+func buildMonitorJSONDefinition(d utils.Resource) (string, error) {
+	monitor := d.Get("monitor").(string)
+	notification := buildMonitorNotification(d)
+	if notification == nil {
+		return monitor, nil
+	}
+
+	attrMap, err := structure.ExpandJsonFromString(monitor)
+	if err != nil {
+		return "", err
+	}
+	if attrMap["message"], err = notification.Message(); err != nil {
+		return "", err
+	}
+	if notification.Escalation != nil {
+		escalationMessage, err := notification.EscalationMessage()
+		if err != nil {
+			return "", err
+		}
+		options, ok := attrMap["options"].(map[string]interface{})
+		if !ok {
+			options = make(map[string]interface{})
+			attrMap["options"] = options
+		}
+		options["escalation_message"] = escalationMessage
+	}
+	return structure.FlattenJsonToString(attrMap)
+}
+
 func updateMonitorJSONState(d *schema.ResourceData, monitor map[string]interface{}) diag.Diagnostics {
 	if v, ok := monitor["url"]; ok {
 		if err := d.Set("url", v.(string)); err != nil {
@@ -199,16 +262,7 @@ func updateMonitorJSONState(d *schema.ResourceData, monitor map[string]interface
 		}
 	}
 
-	// Remove computed fields from the object
-	for _, f := range monitorComputedFields {
-		utils.DeleteKeyInMap(monitor, strings.Split(f, "."))
-	}
-
-	// restricted_roles is a special case and exporting the field from UI does not include this field. But the api
-	// returns a `null` value on creation. If null we remove the field from state to avoid unnecessary diffs.
-	if val := reflect.ValueOf(monitor["restricted_roles"]); !val.IsValid() {
-		utils.DeleteKeyInMap(monitor, []string{"restricted_roles"})
-	}
+	normalizeMonitorJSON(monitor)
 
 	monitorString, err := structure.FlattenJsonToString(monitor)
 	if err != nil {
@@ -218,5 +272,49 @@ func updateMonitorJSONState(d *schema.ResourceData, monitor map[string]interface
 	if err = d.Set("monitor", monitorString); err != nil {
 		return diag.FromErr(err)
 	}
+	if err = d.Set("monitor_fields", utils.FlattenJSONPointers(monitor)); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
+
+// normalizeMonitorJSON removes the computed fields and the API defaults from a monitor definition
+func normalizeMonitorJSON(monitor map[string]interface{}) {
+	for _, f := range monitorComputedFields {
+		utils.DeleteKeyInMap(monitor, strings.Split(f, "."))
+	}
+	if name, ok := monitor["name"]; ok {
+		if name, ok := name.(string); ok {
+			monitor["name"] = strings.TrimSpace(name)
+		}
+	}
+	if msg, ok := monitor["message"]; ok {
+		if msg, ok := msg.(string); ok {
+			monitor["message"] = strings.TrimSpace(msg)
+		}
+	}
+
+	// restricted_roles is a special case and exporting the field from UI does not include this field. But the api
+	// returns a `null` value on creation, which is removed with the other null values to avoid unnecessary diffs.
+	utils.NormalizeMonitorJSON(monitor)
+}
+
+// customizeDiffMonitorJSONFields plans the change of each field of the definition
+func customizeDiffMonitorJSONFields(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange("monitor") && !diff.HasChange("notification") {
+		return nil
+	}
+	if !diff.NewValueKnown("monitor") || !diff.NewValueKnown("notification") {
+		return diff.SetNewComputed("monitor_fields")
+	}
+	monitor, err := buildMonitorJSONDefinition(diff)
+	if err != nil {
+		return err
+	}
+	attrMap, err := structure.ExpandJsonFromString(monitor)
+	if err != nil {
+		return err
+	}
+	normalizeMonitorJSON(attrMap)
+	return diff.SetNew("monitor_fields", utils.FlattenJSONPointers(attrMap))
+}
@@ -0,0 +1,643 @@
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+func resourceDatadogNotebook() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog notebook resource. This can be used to create and manage Datadog notebooks, e.g. postmortems and runbooks.",
+		CreateContext: resourceDatadogNotebookCreate,
+		ReadContext:   resourceDatadogNotebookRead,
+		UpdateContext: resourceDatadogNotebookUpdate,
+		DeleteContext: resourceDatadogNotebookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the notebook.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"status": {
+				Description:      "The publication status of the notebook.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(datadogV1.NOTEBOOKSTATUS_PUBLISHED),
+				ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewNotebookStatusFromValue),
+			},
+			"time": getNotebookTimeSchema("The global time range of the notebook, used by the cells without their own `time`.", true),
+			"metadata": {
+				Description: "The metadata of the notebook.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"is_template": {
+							Description: "Whether or not the notebook is a template.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"take_snapshots": {
+							Description: "Whether or not the notebook takes snapshots of its graphs.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+						},
+						"type": {
+							Description:      "The type of the notebook.",
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewNotebookMetadataTypeFromValue),
+						},
+					},
+				},
+			},
+			"cell": {
+				Description: "The cells of the notebook, in display order. Exactly one definition must be set in each cell.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: getNotebookCellSchema(),
+				},
+			},
+		},
+	}
+}
+
+// notebookCellDefinitions lists the definition blocks of notebook cells
+var notebookCellDefinitions = []string{
+	"markdown_definition",
+	"timeseries_definition",
+	"toplist_definition",
+	"heatmap_definition",
+	"distribution_definition",
+	"log_stream_definition",
+}
+
+func getNotebookCellSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the cell.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"markdown_definition": {
+			Description: "The definition for a Markdown cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"text": {
+						Description: "The Markdown text of the cell.",
+						Type:        schema.TypeString,
+						Required:    true,
+					},
+				},
+			},
+		},
+		"timeseries_definition": {
+			Description: "The definition for a Timeseries cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: getTimeseriesDefinitionSchema(),
+			},
+		},
+		"toplist_definition": {
+			Description: "The definition for a Top List cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: getToplistDefinitionSchema(),
+			},
+		},
+		"heatmap_definition": {
+			Description: "The definition for a Heatmap cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: getHeatmapDefinitionSchema(),
+			},
+		},
+		"distribution_definition": {
+			Description: "The definition for a Distribution cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: getDistributionDefinitionSchema(),
+			},
+		},
+		"log_stream_definition": {
+			Description: "The definition for a Log Stream cell.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: getLogStreamDefinitionSchema(),
+			},
+		},
+		"graph_size": {
+			Description:      "The size of the graph of the cell. Not used by Markdown cells.",
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewNotebookGraphSizeFromValue),
+		},
+		"split_by": {
+			Description: "The tags to split the graph of the cell by. Only used by Timeseries, Top List, Heatmap and Distribution cells.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"keys": {
+						Description: "The tag keys to split the graph by.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"tags": {
+						Description: "The tags to restrict the split to, e.g. `env:prod`.",
+						Type:        schema.TypeList,
+						Required:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"time": getNotebookTimeSchema("The time range of the cell, overriding the notebook `time`. Not used by Markdown cells.", false),
+	}
+}
+
+func getNotebookTimeSchema(description string, required bool) *schema.Schema {
+	return &schema.Schema{
+		Description: description + " Either `live_span`, or `start` and `end` must be set.",
+		Type:        schema.TypeList,
+		Required:    required,
+		Optional:    !required,
+		MaxItems:    1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"live_span": {
+					Description:      "The timeframe ending now.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewWidgetLiveSpanFromValue),
+				},
+				"start": {
+					Description:  "The start of the time range, as an RFC3339 date.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+				"end": {
+					Description:  "The end of the time range, as an RFC3339 date.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsRFC3339Time,
+				},
+				"live": {
+					Description: "Whether the time range between `start` and `end` is updated live.",
+					Type:        schema.TypeBool,
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
+// buildDatadogNotebookTime returns the relative or absolute time of a `time` block
+func buildDatadogNotebookTime(terraformTime map[string]interface{}) (*datadogV1.NotebookRelativeTime, *datadogV1.NotebookAbsoluteTime, error) {
+	liveSpan, _ := terraformTime["live_span"].(string)
+	startValue, _ := terraformTime["start"].(string)
+	endValue, _ := terraformTime["end"].(string)
+	if liveSpan != "" {
+		if startValue != "" || endValue != "" {
+			return nil, nil, fmt.Errorf("`live_span` conflicts with `start` and `end`")
+		}
+		return datadogV1.NewNotebookRelativeTime(datadogV1.WidgetLiveSpan(liveSpan)), nil, nil
+	}
+	if startValue == "" || endValue == "" {
+		return nil, nil, fmt.Errorf("either `live_span`, or `start` and `end` must be set")
+	}
+	start, err := time.Parse(time.RFC3339, startValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	end, err := time.Parse(time.RFC3339, endValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	absolute := datadogV1.NewNotebookAbsoluteTime(end, start)
+	if live, ok := terraformTime["live"].(bool); ok && live {
+		absolute.SetLive(live)
+	}
+	return nil, absolute, nil
+}
+
+func buildTerraformNotebookTime(relative *datadogV1.NotebookRelativeTime, absolute *datadogV1.NotebookAbsoluteTime) []map[string]interface{} {
+	if relative != nil {
+		return []map[string]interface{}{{"live_span": relative.GetLiveSpan()}}
+	}
+	if absolute != nil {
+		return []map[string]interface{}{{
+			"start": absolute.GetStart().Format(time.RFC3339),
+			"end":   absolute.GetEnd().Format(time.RFC3339),
+			"live":  absolute.GetLive(),
+		}}
+	}
+	return nil
+}
+
+// notebookCellOptions is implemented by the attributes of the graph cells
+type notebookCellOptions interface {
+	SetGraphSize(v datadogV1.NotebookGraphSize)
+	GetGraphSizeOk() (*datadogV1.NotebookGraphSize, bool)
+	SetTime(v datadogV1.NotebookCellTime)
+	GetTimeOk() (*datadogV1.NotebookCellTime, bool)
+}
+
+// notebookCellSplitBy is implemented by the attributes of the cells whose graph can be split
+type notebookCellSplitBy interface {
+	SetSplitBy(v datadogV1.NotebookSplitBy)
+	GetSplitByOk() (*datadogV1.NotebookSplitBy, bool)
+}
+
+func buildDatadogNotebookCellOptions(terraformCell map[string]interface{}, attributes notebookCellOptions) error {
+	if v, ok := terraformCell["graph_size"].(string); ok && v != "" {
+		attributes.SetGraphSize(datadogV1.NotebookGraphSize(v))
+	}
+	if v, ok := terraformCell["time"].([]interface{}); ok && len(v) > 0 {
+		if terraformTime, ok := v[0].(map[string]interface{}); ok {
+			relative, absolute, err := buildDatadogNotebookTime(terraformTime)
+			if err != nil {
+				return fmt.Errorf("cell time: %v", err)
+			}
+			if relative != nil {
+				attributes.SetTime(datadogV1.NotebookRelativeTimeAsNotebookCellTime(relative))
+			} else {
+				attributes.SetTime(datadogV1.NotebookAbsoluteTimeAsNotebookCellTime(absolute))
+			}
+		}
+	}
+	v, ok := terraformCell["split_by"].([]interface{})
+	if !ok || len(v) == 0 {
+		return nil
+	}
+	splitByAttributes, ok := attributes.(notebookCellSplitBy)
+	if !ok {
+		return fmt.Errorf("`split_by` isn't supported by this cell")
+	}
+	if terraformSplitBy, ok := v[0].(map[string]interface{}); ok {
+		splitByAttributes.SetSplitBy(*datadogV1.NewNotebookSplitBy(
+			getStringList(terraformSplitBy["keys"]),
+			getStringList(terraformSplitBy["tags"]),
+		))
+	}
+	return nil
+}
+
+func getStringList(v interface{}) []string {
+	l, _ := v.([]interface{})
+	s := make([]string, 0, len(l))
+	for _, e := range l {
+		if e, ok := e.(string); ok {
+			s = append(s, e)
+		}
+	}
+	return s
+}
+
+// buildDatadogNotebookCellAttributes builds the attributes of a cell from its Terraform definition
+func buildDatadogNotebookCellAttributes(terraformCell map[string]interface{}) (*datadogV1.NotebookCellCreateRequestAttributes, error) {
+	var definitions []string
+	for _, name := range notebookCellDefinitions {
+		if def, ok := terraformCell[name].([]interface{}); ok && len(def) > 0 {
+			definitions = append(definitions, name)
+		}
+	}
+	if len(definitions) != 1 {
+		return nil, fmt.Errorf("exactly one definition must be set in each cell, got %d", len(definitions))
+	}
+	def, ok := terraformCell[definitions[0]].([]interface{})[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must not be empty", definitions[0])
+	}
+
+	var attributes datadogV1.NotebookCellCreateRequestAttributes
+	var options notebookCellOptions
+	switch definitions[0] {
+	case "markdown_definition":
+		if splitBy, ok := terraformCell["split_by"].([]interface{}); ok && len(splitBy) > 0 {
+			return nil, fmt.Errorf("`split_by` isn't supported by Markdown cells")
+		}
+		markdown := datadogV1.NewNotebookMarkdownCellAttributes(*datadogV1.NewNotebookMarkdownCellDefinition(
+			def["text"].(string), datadogV1.NOTEBOOKMARKDOWNCELLDEFINITIONTYPE_MARKDOWN))
+		return &datadogV1.NotebookCellCreateRequestAttributes{NotebookMarkdownCellAttributes: markdown}, nil
+	case "timeseries_definition":
+		cell := datadogV1.NewNotebookTimeseriesCellAttributes(*buildDatadogTimeseriesDefinition(def))
+		attributes, options = datadogV1.NotebookTimeseriesCellAttributesAsNotebookCellCreateRequestAttributes(cell), cell
+	case "toplist_definition":
+		cell := datadogV1.NewNotebookToplistCellAttributes(*buildDatadogToplistDefinition(def))
+		attributes, options = datadogV1.NotebookToplistCellAttributesAsNotebookCellCreateRequestAttributes(cell), cell
+	case "heatmap_definition":
+		cell := datadogV1.NewNotebookHeatMapCellAttributes(*buildDatadogHeatmapDefinition(def))
+		attributes, options = datadogV1.NotebookHeatMapCellAttributesAsNotebookCellCreateRequestAttributes(cell), cell
+	case "distribution_definition":
+		cell := datadogV1.NewNotebookDistributionCellAttributes(*buildDatadogDistributionDefinition(def))
+		attributes, options = datadogV1.NotebookDistributionCellAttributesAsNotebookCellCreateRequestAttributes(cell), cell
+	case "log_stream_definition":
+		cell := datadogV1.NewNotebookLogStreamCellAttributes(*buildDatadogLogStreamDefinition(def))
+		attributes, options = datadogV1.NotebookLogStreamCellAttributesAsNotebookCellCreateRequestAttributes(cell), cell
+	}
+	if err := buildDatadogNotebookCellOptions(terraformCell, options); err != nil {
+		return nil, err
+	}
+	return &attributes, nil
+}
+
+// buildDatadogNotebookAttributes returns the attributes shared by the create and update requests, and the
+// attributes of each cell
+func buildDatadogNotebookAttributes(d *schema.ResourceData) (datadogV1.NotebookGlobalTime, *datadogV1.NotebookMetadata, []datadogV1.NotebookCellCreateRequestAttributes, error) {
+	var globalTime datadogV1.NotebookGlobalTime
+	relative, absolute, err := buildDatadogNotebookTime(d.Get("time.0").(map[string]interface{}))
+	if err != nil {
+		return globalTime, nil, nil, fmt.Errorf("notebook time: %v", err)
+	}
+	if relative != nil {
+		globalTime = datadogV1.NotebookRelativeTimeAsNotebookGlobalTime(relative)
+	} else {
+		globalTime = datadogV1.NotebookAbsoluteTimeAsNotebookGlobalTime(absolute)
+	}
+
+	var metadata *datadogV1.NotebookMetadata
+	if v, ok := d.GetOk("metadata.0"); ok {
+		terraformMetadata := v.(map[string]interface{})
+		metadata = datadogV1.NewNotebookMetadata()
+		metadata.SetIsTemplate(terraformMetadata["is_template"].(bool))
+		metadata.SetTakeSnapshots(terraformMetadata["take_snapshots"].(bool))
+		if notebookType, ok := terraformMetadata["type"].(string); ok && notebookType != "" {
+			metadata.SetType(datadogV1.NotebookMetadataType(notebookType))
+		} else {
+			metadata.SetTypeNil()
+		}
+	}
+
+	var cells []datadogV1.NotebookCellCreateRequestAttributes
+	for i, terraformCell := range d.Get("cell").([]interface{}) {
+		terraformCell, ok := terraformCell.(map[string]interface{})
+		if !ok {
+			return globalTime, nil, nil, fmt.Errorf("cell %d must not be empty", i)
+		}
+		attributes, err := buildDatadogNotebookCellAttributes(terraformCell)
+		if err != nil {
+			return globalTime, nil, nil, fmt.Errorf("cell %d: %v", i, err)
+		}
+		cells = append(cells, *attributes)
+	}
+	return globalTime, metadata, cells, nil
+}
+
+func resourceDatadogNotebookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	globalTime, metadata, cells, err := buildDatadogNotebookAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	createCells := make([]datadogV1.NotebookCellCreateRequest, 0, len(cells))
+	for _, attributes := range cells {
+		createCells = append(createCells, *datadogV1.NewNotebookCellCreateRequest(attributes, datadogV1.NOTEBOOKCELLRESOURCETYPE_NOTEBOOK_CELLS))
+	}
+	attributes := datadogV1.NewNotebookCreateDataAttributes(createCells, d.Get("name").(string), globalTime)
+	attributes.SetStatus(datadogV1.NotebookStatus(d.Get("status").(string)))
+	if metadata != nil {
+		attributes.SetMetadata(*metadata)
+	}
+	body := datadogV1.NewNotebookCreateRequest(*datadogV1.NewNotebookCreateData(*attributes, datadogV1.NOTEBOOKRESOURCETYPE_NOTEBOOKS))
+
+	resp, httpresp, err := apiInstances.GetNotebooksApiV1().CreateNotebook(auth, *body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error creating notebook")
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		return diag.FromErr(err)
+	}
+	data := resp.GetData()
+	d.SetId(strconv.FormatInt(data.GetId(), 10))
+
+	return updateNotebookState(d, &data)
+}
+
+func resourceDatadogNotebookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	resp, httpresp, err := apiInstances.GetNotebooksApiV1().GetNotebook(auth, id)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting notebook")
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		return diag.FromErr(err)
+	}
+	data := resp.GetData()
+
+	return updateNotebookState(d, &data)
+}
+
+func resourceDatadogNotebookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	globalTime, metadata, cells, err := buildDatadogNotebookAttributes(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Cells are matched with the existing ones by position, the cells without ID are created and the
+	// cells missing from the request are deleted
+	oldCells, _ := d.GetChange("cell")
+	updateCells := make([]datadogV1.NotebookUpdateCell, 0, len(cells))
+	for i, attributes := range cells {
+		var cellID string
+		if i < len(oldCells.([]interface{})) {
+			if oldCell, ok := oldCells.([]interface{})[i].(map[string]interface{}); ok {
+				cellID, _ = oldCell["id"].(string)
+			}
+		}
+		if cellID == "" {
+			cell := datadogV1.NewNotebookCellCreateRequest(attributes, datadogV1.NOTEBOOKCELLRESOURCETYPE_NOTEBOOK_CELLS)
+			updateCells = append(updateCells, datadogV1.NotebookCellCreateRequestAsNotebookUpdateCell(cell))
+			continue
+		}
+		updateAttributes := datadogV1.NotebookCellUpdateRequestAttributes{
+			NotebookMarkdownCellAttributes:     attributes.NotebookMarkdownCellAttributes,
+			NotebookTimeseriesCellAttributes:   attributes.NotebookTimeseriesCellAttributes,
+			NotebookToplistCellAttributes:      attributes.NotebookToplistCellAttributes,
+			NotebookHeatMapCellAttributes:      attributes.NotebookHeatMapCellAttributes,
+			NotebookDistributionCellAttributes: attributes.NotebookDistributionCellAttributes,
+			NotebookLogStreamCellAttributes:    attributes.NotebookLogStreamCellAttributes,
+		}
+		cell := datadogV1.NewNotebookCellUpdateRequest(updateAttributes, cellID, datadogV1.NOTEBOOKCELLRESOURCETYPE_NOTEBOOK_CELLS)
+		updateCells = append(updateCells, datadogV1.NotebookCellUpdateRequestAsNotebookUpdateCell(cell))
+	}
+	attributes := datadogV1.NewNotebookUpdateDataAttributes(updateCells, d.Get("name").(string), globalTime)
+	attributes.SetStatus(datadogV1.NotebookStatus(d.Get("status").(string)))
+	if metadata != nil {
+		attributes.SetMetadata(*metadata)
+	}
+	body := datadogV1.NewNotebookUpdateRequest(*datadogV1.NewNotebookUpdateData(*attributes, datadogV1.NOTEBOOKRESOURCETYPE_NOTEBOOKS))
+
+	resp, httpresp, err := apiInstances.GetNotebooksApiV1().UpdateNotebook(auth, id, *body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error updating notebook")
+	}
+	if err := utils.CheckForUnparsed(resp); err != nil {
+		return diag.FromErr(err)
+	}
+	data := resp.GetData()
+
+	return updateNotebookState(d, &data)
+}
+
+func resourceDatadogNotebookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if httpresp, err := apiInstances.GetNotebooksApiV1().DeleteNotebook(auth, id); err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error deleting notebook")
+	}
+	return nil
+}
+
+func buildTerraformNotebookCell(datadogCell datadogV1.NotebookCellResponse, k *utils.ResourceDataKey) map[string]interface{} {
+	terraformCell := map[string]interface{}{"id": datadogCell.GetId()}
+	attributes := datadogCell.GetAttributes()
+
+	var options notebookCellOptions
+	switch {
+	case attributes.NotebookMarkdownCellAttributes != nil:
+		definition := attributes.NotebookMarkdownCellAttributes.GetDefinition()
+		terraformCell["markdown_definition"] = []map[string]interface{}{{"text": definition.GetText()}}
+	case attributes.NotebookTimeseriesCellAttributes != nil:
+		cell := attributes.NotebookTimeseriesCellAttributes
+		terraformCell["timeseries_definition"] = []map[string]interface{}{buildTerraformTimeseriesDefinition(cell.GetDefinition(), k.Add("timeseries_definition.0"))}
+		k.Remove("timeseries_definition.0")
+		options = cell
+	case attributes.NotebookToplistCellAttributes != nil:
+		cell := attributes.NotebookToplistCellAttributes
+		terraformCell["toplist_definition"] = []map[string]interface{}{buildTerraformToplistDefinition(cell.GetDefinition(), k.Add("toplist_definition.0"))}
+		k.Remove("toplist_definition.0")
+		options = cell
+	case attributes.NotebookHeatMapCellAttributes != nil:
+		cell := attributes.NotebookHeatMapCellAttributes
+		terraformCell["heatmap_definition"] = []map[string]interface{}{buildTerraformHeatmapDefinition(cell.GetDefinition(), k.Add("heatmap_definition.0"))}
+		k.Remove("heatmap_definition.0")
+		options = cell
+	case attributes.NotebookDistributionCellAttributes != nil:
+		cell := attributes.NotebookDistributionCellAttributes
+		terraformCell["distribution_definition"] = []map[string]interface{}{buildTerraformDistributionDefinition(cell.GetDefinition(), k.Add("distribution_definition.0"))}
+		k.Remove("distribution_definition.0")
+		options = cell
+	case attributes.NotebookLogStreamCellAttributes != nil:
+		cell := attributes.NotebookLogStreamCellAttributes
+		terraformCell["log_stream_definition"] = []map[string]interface{}{buildTerraformLogStreamDefinition(cell.GetDefinition(), k.Add("log_stream_definition.0"))}
+		k.Remove("log_stream_definition.0")
+		options = cell
+	}
+	if options == nil {
+		return terraformCell
+	}
+
+	if graphSize, ok := options.GetGraphSizeOk(); ok {
+		terraformCell["graph_size"] = *graphSize
+	}
+	if cellTime, ok := options.GetTimeOk(); ok && cellTime != nil {
+		terraformCell["time"] = buildTerraformNotebookTime(cellTime.NotebookRelativeTime, cellTime.NotebookAbsoluteTime)
+	}
+	if splitByOptions, ok := options.(notebookCellSplitBy); ok {
+		if splitBy, ok := splitByOptions.GetSplitByOk(); ok && (len(splitBy.GetKeys()) > 0 || len(splitBy.GetTags()) > 0) {
+			terraformCell["split_by"] = []map[string]interface{}{{
+				"keys": splitBy.GetKeys(),
+				"tags": splitBy.GetTags(),
+			}}
+		}
+	}
+	return terraformCell
+}
+
+func updateNotebookState(d *schema.ResourceData, notebook *datadogV1.NotebookResponseData) diag.Diagnostics {
+	attributes := notebook.GetAttributes()
+
+	if err := d.Set("name", attributes.GetName()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", attributes.GetStatus()); err != nil {
+		return diag.FromErr(err)
+	}
+	globalTime := attributes.GetTime()
+	if err := d.Set("time", buildTerraformNotebookTime(globalTime.NotebookRelativeTime, globalTime.NotebookAbsoluteTime)); err != nil {
+		return diag.FromErr(err)
+	}
+	if metadata, ok := attributes.GetMetadataOk(); ok {
+		terraformMetadata := map[string]interface{}{
+			"is_template":    metadata.GetIsTemplate(),
+			"take_snapshots": metadata.GetTakeSnapshots(),
+			"type":           metadata.GetType(),
+		}
+		if err := d.Set("metadata", []map[string]interface{}{terraformMetadata}); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	cells := make([]map[string]interface{}, 0, len(attributes.GetCells()))
+	for i, cell := range attributes.GetCells() {
+		cells = append(cells, buildTerraformNotebookCell(cell, utils.NewResourceDataKey(d, fmt.Sprintf("cell.%d", i))))
+	}
+	if err := d.Set("cell", cells); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package datadog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+var notebookComputedFields = []string{"author", "created", "modified"}
+
+// notebookMetadataDefaults are the values of the notebook metadata when they aren't set
+var notebookMetadataDefaults = map[string]interface{}{"is_template": false, "take_snapshots": false}
+
+// notebookCellDefaults are the values of the cell options when they aren't set
+var notebookCellDefaults = map[string]interface{}{
+	"split_by": map[string]interface{}{"keys": []interface{}{}, "tags": []interface{}{}},
+}
+
+const notebookPath = "/api/v1/notebooks"
+
+func resourceDatadogNotebookJSON() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog notebook JSON resource. This can be used to create and manage Datadog notebooks using the JSON definition.",
+		CreateContext: resourceDatadogNotebookJSONCreate,
+		ReadContext:   resourceDatadogNotebookJSONRead,
+		UpdateContext: resourceDatadogNotebookJSONUpdate,
+		DeleteContext: resourceDatadogNotebookJSONDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"notebook": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					attrMap, _ := structure.ExpandJsonFromString(v.(string))
+					prepNotebookResource(attrMap)
+					res, _ := structure.FlattenJsonToString(attrMap)
+					return res
+				},
+				Description: "The JSON formatted attributes of the notebook, i.e. its `name`, `cells`, `time`, `status` and `metadata`.",
+			},
+		},
+	}
+}
+
+func resourceDatadogNotebookJSONRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	id := d.Id()
+
+	respByte, httpResp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", notebookPath+"/"+id, nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	respMap, err := utils.ConvertResponseByteToMap(respByte)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return updateNotebookJSONState(d, respMap)
+}
+
+func resourceDatadogNotebookJSONCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	notebook, err := buildNotebookJSONRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "POST", notebookPath, &notebook)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error creating notebook")
+	}
+
+	respMap, err := utils.ConvertResponseByteToMap(respByte)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data, _ := respMap["data"].(map[string]interface{})
+	id, ok := data["id"]
+	if !ok {
+		return diag.FromErr(errors.New("error retrieving id from response"))
+	}
+	d.SetId(fmt.Sprintf("%.0f", id))
+
+	return updateNotebookJSONState(d, respMap)
+}
+
+func resourceDatadogNotebookJSONUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	notebook, err := buildNotebookJSONRequest(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	id := d.Id()
+
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "PUT", notebookPath+"/"+id, &notebook)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error updating notebook")
+	}
+
+	respMap, err := utils.ConvertResponseByteToMap(respByte)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return updateNotebookJSONState(d, respMap)
+}
+
+func resourceDatadogNotebookJSONDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	id := d.Id()
+
+	_, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "DELETE", notebookPath+"/"+id, nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error deleting notebook")
+	}
+
+	return nil
+}
+
+// buildNotebookJSONRequest wraps the notebook attributes in the request envelope of the notebooks API
+func buildNotebookJSONRequest(d *schema.ResourceData) (string, error) {
+	attrMap, err := structure.ExpandJsonFromString(d.Get("notebook").(string))
+	if err != nil {
+		return "", err
+	}
+	return structure.FlattenJsonToString(map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "notebooks",
+			"attributes": attrMap,
+		},
+	})
+}
+
+func updateNotebookJSONState(d *schema.ResourceData, resp map[string]interface{}) diag.Diagnostics {
+	data, _ := resp["data"].(map[string]interface{})
+	attrMap, ok := data["attributes"].(map[string]interface{})
+	if !ok {
+		return diag.FromErr(errors.New("error retrieving attributes from response"))
+	}
+
+	prepNotebookResource(attrMap)
+
+	notebookString, err := structure.FlattenJsonToString(attrMap)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("notebook", notebookString); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func prepNotebookResource(attrMap map[string]interface{}) map[string]interface{} {
+	// Remove computed fields when comparing diffs
+	for _, f := range notebookComputedFields {
+		delete(attrMap, f)
+	}
+	// 'published' is the default status
+	if status, ok := attrMap["status"].(string); ok && status == "published" {
+		delete(attrMap, "status")
+	}
+	// Remove the metadata echoed with their default values
+	if metadata, ok := attrMap["metadata"].(map[string]interface{}); ok {
+		deleteNotebookDefaults(metadata, notebookMetadataDefaults)
+		if len(metadata) == 0 {
+			delete(attrMap, "metadata")
+		}
+	} else if attrMap["metadata"] == nil {
+		delete(attrMap, "metadata")
+	}
+	// Remove every cell id too, and the cell options echoed with their default values
+	if cells, ok := attrMap["cells"].([]interface{}); ok {
+		for _, c := range cells {
+			if cell, ok := c.(map[string]interface{}); ok {
+				delete(cell, "id")
+				if attributes, ok := cell["attributes"].(map[string]interface{}); ok {
+					deleteNotebookDefaults(attributes, notebookCellDefaults)
+				}
+			}
+		}
+	}
+
+	return attrMap
+}
+
+// deleteNotebookDefaults removes the fields set to null or to their default value
+func deleteNotebookDefaults(attrMap map[string]interface{}, defaults map[string]interface{}) {
+	for k, v := range attrMap {
+		if v == nil {
+			delete(attrMap, k)
+			continue
+		}
+		if d, ok := defaults[k]; ok && reflect.DeepEqual(v, d) {
+			delete(attrMap, k)
+		}
+	}
+}
@@ -109,7 +109,7 @@ func resourceDatadogRoleCustomizeDiff(ctx context.Context, diff *schema.Resource
 	}
 
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	// Get a list of all valid permissions
 	validPerms, err := getValidPermissions(auth, apiInstances)
@@ -134,7 +134,7 @@ func resourceDatadogRoleCustomizeDiff(ctx context.Context, diff *schema.Resource
 
 func resourceDatadogRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	roleReq := buildRoleCreateRequest(d)
 	createResp, httpResponse, err := apiInstances.GetRolesApiV2().CreateRole(auth, roleReq)
@@ -240,7 +240,7 @@ func appendPerm(perms []map[string]string, permID string, permsIDToName map[stri
 
 func resourceDatadogRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	// Get the role
 	resp, httpresp, err := apiInstances.GetRolesApiV2().GetRole(auth, d.Id())
@@ -260,7 +260,7 @@ func resourceDatadogRoleRead(ctx context.Context, d *schema.ResourceData, meta i
 
 func resourceDatadogRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	if d.HasChange("name") || d.HasChange("permission") {
 		roleReq := buildRoleUpdateRequest(d)
@@ -282,7 +282,7 @@ func resourceDatadogRoleUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 func resourceDatadogRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	apiInstances := meta.(*ProviderConfiguration).DatadogApiInstances
-	auth := meta.(*ProviderConfiguration).Auth
+	auth := meta.(*ProviderConfiguration).AuthContext(ctx)
 
 	httpResponse, err := apiInstances.GetRolesApiV2().DeleteRole(auth, d.Id())
 	if err != nil {
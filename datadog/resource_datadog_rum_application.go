@@ -45,7 +45,7 @@ func resourceDatadogRUMApplication() *schema.Resource {
 func resourceDatadogRUMApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetRumApiV2().GetRUMApplication(auth, d.Id())
 	if err != nil {
@@ -73,7 +73,7 @@ func resourceDatadogRUMApplicationCreate(ctx context.Context, d *schema.Resource
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetRumApiV2().CreateRUMApplication(auth, body)
 	if err != nil {
@@ -85,7 +85,7 @@ func resourceDatadogRUMApplicationCreate(ctx context.Context, d *schema.Resource
 	return updateRUMApplicationState(d, resp.Data)
 }
 
-func resourceDatadogRUMApplicationUpdate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogRUMApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	body := datadogV2.RUMApplicationUpdateRequest{
 		Data: datadogV2.RUMApplicationUpdate{
 			Attributes: &datadogV2.RUMApplicationUpdateAttributes{
@@ -99,7 +99,7 @@ func resourceDatadogRUMApplicationUpdate(_ context.Context, d *schema.ResourceDa
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	resp, httpResponse, err := apiInstances.GetRumApiV2().UpdateRUMApplication(auth, d.Id(), body)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error updating RUM application")
@@ -108,10 +108,10 @@ func resourceDatadogRUMApplicationUpdate(_ context.Context, d *schema.ResourceDa
 	return updateRUMApplicationState(d, resp.Data)
 }
 
-func resourceDatadogRUMApplicationDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogRUMApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	httpResponse, err := apiInstances.GetRumApiV2().DeleteRUMApplication(auth, d.Id())
 	if err != nil {
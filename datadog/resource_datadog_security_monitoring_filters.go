@@ -85,7 +85,7 @@ func securityMonitoringFilterSchema() map[string]*schema.Schema {
 func resourceDatadogSecurityMonitoringFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	filterCreate := buildSecMonFilterCreatePayload(d)
 
@@ -106,7 +106,7 @@ func resourceDatadogSecurityMonitoringFilterCreate(ctx context.Context, d *schem
 func resourceDatadogSecurityMonitoringFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 	filterResponse, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().GetSecurityFilter(auth, id)
@@ -136,7 +136,7 @@ func resourceDatadogSecurityMonitoringFilterRead(ctx context.Context, d *schema.
 func resourceDatadogSecurityMonitoringFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	filterId := d.Id()
 
@@ -152,7 +152,7 @@ func resourceDatadogSecurityMonitoringFilterUpdate(ctx context.Context, d *schem
 func resourceDatadogSecurityMonitoringFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	filterId := d.Id()
 
@@ -20,11 +20,17 @@ func resourceDatadogSecurityMonitoringRule() *schema.Resource {
 		ReadContext:   resourceDatadogSecurityMonitoringRuleRead,
 		UpdateContext: resourceDatadogSecurityMonitoringRuleUpdate,
 		DeleteContext: resourceDatadogSecurityMonitoringRuleDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		Schema: datadogSecurityMonitoringRuleSchema(),
+		Schema: func() map[string]*schema.Schema {
+			// tags_all is only relevant to the resource, the schema is shared with the rules data source
+			ruleSchema := datadogSecurityMonitoringRuleSchema()
+			ruleSchema["tags_all"] = getTagsAllSchema()
+			return ruleSchema
+		}(),
 	}
 }
 
@@ -391,12 +397,17 @@ type securityMonitoringRuleResponseInterface interface {
 func resourceDatadogSecurityMonitoringRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ruleCreate, err := buildCreatePayload(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if payload, ok := ruleCreate.GetActualInstance().(securityMonitoringRuleCreateInterface); ok {
+		if tags := mergeDefaultTags(meta, payload.GetTags()); len(tags) > 0 {
+			payload.SetTags(tags)
+		}
+	}
 	response, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().CreateSecurityMonitoringRule(auth, ruleCreate)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error creating security monitoring rule")
@@ -407,8 +418,10 @@ func resourceDatadogSecurityMonitoringRuleCreate(ctx context.Context, d *schema.
 
 	if response.SecurityMonitoringStandardRuleResponse != nil {
 		d.SetId(response.SecurityMonitoringStandardRuleResponse.GetId())
+		d.Set("tags_all", response.SecurityMonitoringStandardRuleResponse.GetTags())
 	} else if response.SecurityMonitoringSignalRuleResponse != nil {
 		d.SetId(response.SecurityMonitoringSignalRuleResponse.GetId())
+		d.Set("tags_all", response.SecurityMonitoringSignalRuleResponse.GetTags())
 	} else {
 		return diag.FromErr(fmt.Errorf("SecurityMonitoringStandardRuleResponse and SecurityMonitoringSignalRuleResponse are both empty"))
 	}
@@ -759,7 +772,7 @@ func buildPayloadFilters(tfFilters []interface{}) []datadogV2.SecurityMonitoring
 func resourceDatadogSecurityMonitoringRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 	ruleResponse, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().GetSecurityMonitoringRule(auth, id)
@@ -788,6 +801,8 @@ func updateCommonResourceDataFromResponse(d *schema.ResourceData, ruleResponse s
 	d.Set("name", ruleResponse.GetName())
 	d.Set("has_extended_title", ruleResponse.GetHasExtendedTitle())
 	d.Set("enabled", ruleResponse.GetIsEnabled())
+	// tags are not read back from the API, tags_all reflects the remote tags including the provider default_tags
+	d.Set("tags_all", ruleResponse.GetTags())
 
 	options := extractTfOptions(ruleResponse.GetOptions())
 
@@ -948,12 +963,15 @@ func extractTfOptions(options datadogV2.SecurityMonitoringRuleOptions) map[strin
 func resourceDatadogSecurityMonitoringRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	ruleUpdate, err := buildUpdatePayload(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if tags := mergeDefaultTags(meta, ruleUpdate.GetTags()); len(tags) > 0 {
+		ruleUpdate.SetTags(tags)
+	}
 	response, httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().UpdateSecurityMonitoringRule(auth, d.Id(), ruleUpdate)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error updating security monitoring rule")
@@ -1159,7 +1177,7 @@ func buildUpdateSignalRuleQuery(tfQuery interface{}) (datadogV2.SecurityMonitori
 func resourceDatadogSecurityMonitoringRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetSecurityMonitoringApiV2().DeleteSecurityMonitoringRule(auth, d.Id()); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error deleting security monitoring rule")
@@ -86,7 +86,7 @@ func buildDatadogServiceAccountV2Request(d *schema.ResourceData) *datadogV2.Serv
 func resourceDatadogServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	serviceAccountRequest := buildDatadogServiceAccountV2Request(d)
 	var userID string
@@ -157,7 +157,7 @@ func resourceDatadogServiceAccountCreate(ctx context.Context, d *schema.Resource
 			oldRoles.Add(existingRole.GetId())
 		}
 
-		if err := updateRoles(meta, userID, oldRoles, newRoles); err != nil {
+		if err := updateRoles(ctx, meta, userID, oldRoles, newRoles); err != nil {
 			return err
 		}
 
@@ -215,7 +215,7 @@ func updateServiceAccountStateV2(d *schema.ResourceData, user *datadogV2.UserRes
 func resourceDatadogServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	userResponse, httpResponse, err := apiInstances.GetUsersApiV2().GetUser(auth, d.Id())
 
@@ -239,14 +239,14 @@ func resourceDatadogServiceAccountUpdate(ctx context.Context, d *schema.Resource
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if d.HasChange("roles") {
 		oldRolesI, newRolesI := d.GetChange("roles")
 		oldRoles := oldRolesI.(*schema.Set)
 		newRoles := newRolesI.(*schema.Set)
 
-		if err := updateRoles(meta, d.Id(), oldRoles, newRoles); err != nil {
+		if err := updateRoles(ctx, meta, d.Id(), oldRoles, newRoles); err != nil {
 			return err
 		}
 	}
@@ -267,7 +267,7 @@ func resourceDatadogServiceAccountUpdate(ctx context.Context, d *schema.Resource
 func resourceDatadogServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetUsersApiV2().DisableUser(auth, d.Id()); err != nil {
 		if httpResponse != nil && httpResponse.StatusCode == 404 {
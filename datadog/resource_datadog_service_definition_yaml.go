@@ -194,10 +194,10 @@ func isValidServiceDefinition(i interface{}, k string) (warnings []string, error
 	return warnings, errors
 }
 
-func resourceDatadogServiceDefinitionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogServiceDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	id := d.Id()
 	respByte, resp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", serviceDefinitionPath+"/"+id, nil)
@@ -223,7 +223,7 @@ func resourceDatadogServiceDefinitionRead(_ context.Context, d *schema.ResourceD
 func resourceDatadogServiceDefinitionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	definition := d.Get("service_definition").(string)
 
@@ -249,7 +249,7 @@ func resourceDatadogServiceDefinitionCreate(ctx context.Context, d *schema.Resou
 func resourceDatadogServiceDefinitionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	definition := d.Get("service_definition").(string)
 
@@ -271,10 +271,10 @@ func resourceDatadogServiceDefinitionUpdate(ctx context.Context, d *schema.Resou
 	return updateServiceDefinitionState(d, response.Data[0])
 }
 
-func resourceDatadogServiceDefinitionDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceDatadogServiceDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	id := d.Id()
 	_, resp, err := utils.SendRequest(auth, apiInstances.HttpClient, "DELETE", serviceDefinitionPath+"/"+id, nil)
 	if err != nil {
@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
@@ -14,8 +15,13 @@ import (
 	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// sloTypeTimeSlice is the type of time-slice SLOs. The API client doesn't support it yet, these SLOs are returned
+// as unparsed objects and decoded by parseTimeSliceSLO.
+const sloTypeTimeSlice datadogV1.SLOType = "time_slice"
+
 func resourceDatadogServiceLevelObjective() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Provides a Datadog service level objective resource. This can be used to create and manage Datadog service level objectives.",
@@ -56,6 +62,7 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 					},
 				},
 			},
+			"tags_all": getTagsAllSchema(),
 			"thresholds": {
 				Description: "A list of thresholds and targets that define the service level objectives from the provided SLIs.",
 				Type:        schema.TypeList,
@@ -119,7 +126,7 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ForceNew:         true,
-				ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewSLOTypeFromValue),
+				ValidateDiagFunc: validators.ValidateStringEnumValue(datadogV1.SLOTYPE_METRIC, datadogV1.SLOTYPE_MONITOR, sloTypeTimeSlice),
 			},
 			"force_delete": {
 				Description: "A boolean indicating whether this monitor can be deleted even if it's referenced by other resources (for example, dashboards).",
@@ -133,7 +140,7 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 				Type:          schema.TypeList,
 				MaxItems:      1,
 				Optional:      true,
-				ConflictsWith: []string{"monitor_ids", "groups"},
+				ConflictsWith: []string{"monitor_ids", "groups", "sli_specification"},
 				Description:   "The metric query of good / total events",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -155,11 +162,116 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 				},
 			},
 
+			// Time-Slice SLO
+			"sli_specification": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"query", "monitor_ids", "groups"},
+				Description:   "The specification of the service level indicator of time-slice SLOs.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"time_slice": {
+							Type:        schema.TypeList,
+							MaxItems:    1,
+							Required:    true,
+							Description: "The good minutes of the SLO, i.e. the query intervals where the query compared to the threshold is true.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparator": {
+										Description:      "The comparator used to compare the query with the threshold.",
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: validators.ValidateStringEnumValue(">", ">=", "<", "<="),
+									},
+									"threshold": {
+										Description: "The threshold the query is compared to.",
+										Type:        schema.TypeFloat,
+										Required:    true,
+									},
+									"query_interval_seconds": {
+										Description:  "The interval, in seconds, used to evaluate the query. Valid values are `60`, `300`.",
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      300,
+										ValidateFunc: validation.IntInSlice(utils.SLOTimeSliceQueryIntervals),
+									},
+									"query": {
+										Description: "The formula and functions metric query.",
+										Type:        schema.TypeList,
+										MaxItems:    1,
+										Required:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"formula": {
+													Description: "The formula combining the queries, e.g. `query1 / query2`.",
+													Type:        schema.TypeList,
+													MaxItems:    1,
+													Required:    true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"formula_expression": {
+																Description: "The expression of the formula.",
+																Type:        schema.TypeString,
+																Required:    true,
+															},
+														},
+													},
+												},
+												"query": {
+													Description: "The metric queries referenced by the formula.",
+													Type:        schema.TypeList,
+													MinItems:    1,
+													Required:    true,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"metric_query": {
+																Description: "A metric query.",
+																Type:        schema.TypeList,
+																MaxItems:    1,
+																Required:    true,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"data_source": {
+																			Description:      "The data source of the query.",
+																			Type:             schema.TypeString,
+																			Optional:         true,
+																			Default:          "metrics",
+																			ValidateDiagFunc: validators.ValidateStringEnumValue("metrics"),
+																		},
+																		"name": {
+																			Description: "The name of the query, referenced by the formula.",
+																			Type:        schema.TypeString,
+																			Required:    true,
+																		},
+																		"query": {
+																			Description:      "The metric query.",
+																			Type:             schema.TypeString,
+																			Required:         true,
+																			StateFunc:        trimStateValue,
+																			DiffSuppressFunc: diffTrimmedValues,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			// Monitor-Based SLO
 			"monitor_ids": {
 				Type:          schema.TypeSet,
 				Optional:      true,
-				ConflictsWith: []string{"query"},
+				ConflictsWith: []string{"query", "sli_specification"},
 				Description:   "A static set of monitor IDs to use as part of the SLO",
 				Elem:          &schema.Schema{Type: schema.TypeInt, MinItems: 1},
 			},
@@ -167,7 +279,7 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Description:   "A static set of groups to filter monitor-based SLOs",
-				ConflictsWith: []string{"query"},
+				ConflictsWith: []string{"query", "sli_specification"},
 				Elem:          &schema.Schema{Type: schema.TypeString, MinItems: 1},
 			},
 			"validate": {
@@ -189,6 +301,14 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 
 // Use CustomizeDiff to do monitor validation
 func resourceDatadogServiceLevelObjectiveCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffTagsAll(ctx, diff, meta); err != nil {
+		return err
+	}
+
+	if err := validateSLOTimeSliceDiff(diff); err != nil {
+		return err
+	}
+
 	if validate, ok := diff.GetOkExists("validate"); ok && !validate.(bool) {
 		// Explicitly skip validation
 		log.Printf("[DEBUG] Validate is %v, skipping validation", validate.(bool))
@@ -203,7 +323,7 @@ func resourceDatadogServiceLevelObjectiveCustomizeDiff(ctx context.Context, diff
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	if attr, ok := diff.GetOk("monitor_ids"); ok {
 		for _, v := range attr.(*schema.Set).List() {
 			// Check that each monitor being added to the SLO exists
@@ -216,6 +336,193 @@ func resourceDatadogServiceLevelObjectiveCustomizeDiff(ctx context.Context, diff
 	return nil
 }
 
+// validateSLOTimeSliceDiff checks that `sli_specification` is set for time-slice SLOs only, and its query
+func validateSLOTimeSliceDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("type") || !diff.NewValueKnown("sli_specification") {
+		return nil
+	}
+	_, hasSpec := diff.GetOk("sli_specification")
+	isTimeSlice := diff.Get("type").(string) == string(sloTypeTimeSlice)
+	if isTimeSlice && !hasSpec {
+		return fmt.Errorf("`sli_specification` is required for SLOs of type %s", sloTypeTimeSlice)
+	}
+	if !isTimeSlice && hasSpec {
+		return fmt.Errorf("`sli_specification` can only be used with SLOs of type %s", sloTypeTimeSlice)
+	}
+	if !isTimeSlice {
+		return nil
+	}
+	var errs []string
+	for _, err := range utils.ValidateSLOTimeSliceSpec(*buildSLOSliSpecification(diff).TimeSlice) {
+		errs = append(errs, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid time-slice SLO: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// buildSLOSliSpecification returns the `sli_specification` of a time-slice SLO
+func buildSLOSliSpecification(d utils.Resource) *utils.SLOSliSpecification {
+	spec := &utils.SLOTimeSliceSpec{
+		Comparator:           d.Get("sli_specification.0.time_slice.0.comparator").(string),
+		Threshold:            d.Get("sli_specification.0.time_slice.0.threshold").(float64),
+		QueryIntervalSeconds: int64(d.Get("sli_specification.0.time_slice.0.query_interval_seconds").(int)),
+		Query: utils.SLOTimeSliceQuery{
+			Formulas: []utils.SLOTimeSliceFormula{},
+			Queries:  []utils.SLOTimeSliceMetricQuery{},
+		},
+	}
+	for _, f := range d.Get("sli_specification.0.time_slice.0.query.0.formula").([]interface{}) {
+		if f, ok := f.(map[string]interface{}); ok {
+			spec.Query.Formulas = append(spec.Query.Formulas, utils.SLOTimeSliceFormula{Formula: f["formula_expression"].(string)})
+		}
+	}
+	for _, q := range d.Get("sli_specification.0.time_slice.0.query.0.query").([]interface{}) {
+		q, ok := q.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, mq := range q["metric_query"].([]interface{}) {
+			if mq, ok := mq.(map[string]interface{}); ok {
+				spec.Query.Queries = append(spec.Query.Queries, utils.SLOTimeSliceMetricQuery{
+					DataSource: mq["data_source"].(string),
+					Name:       mq["name"].(string),
+					Query:      mq["query"].(string),
+				})
+			}
+		}
+	}
+	return &utils.SLOSliSpecification{TimeSlice: spec}
+}
+
+// buildSLOSliSpecificationState returns the state of `sli_specification`
+func buildSLOSliSpecificationState(spec *utils.SLOSliSpecification) []map[string]interface{} {
+	if spec == nil || spec.TimeSlice == nil {
+		return nil
+	}
+	queries := make([]map[string]interface{}, 0, len(spec.TimeSlice.Query.Queries))
+	for _, q := range spec.TimeSlice.Query.Queries {
+		queries = append(queries, map[string]interface{}{
+			"metric_query": []map[string]interface{}{{
+				"data_source": q.DataSource,
+				"name":        q.Name,
+				"query":       q.Query,
+			}},
+		})
+	}
+	formulas := make([]map[string]interface{}, 0, len(spec.TimeSlice.Query.Formulas))
+	for _, f := range spec.TimeSlice.Query.Formulas {
+		formulas = append(formulas, map[string]interface{}{"formula_expression": f.Formula})
+	}
+	return []map[string]interface{}{{
+		"time_slice": []map[string]interface{}{{
+			"comparator":             spec.TimeSlice.Comparator,
+			"threshold":              spec.TimeSlice.Threshold,
+			"query_interval_seconds": spec.TimeSlice.QueryIntervalSeconds,
+			"query": []map[string]interface{}{{
+				"formula": formulas,
+				"query":   queries,
+			}},
+		}},
+	}}
+}
+
+// parseTimeSliceSLO decodes a time-slice SLO returned as an unparsed object into slo and returns its
+// `sli_specification`. It returns false for unparsed objects of other types.
+func parseTimeSliceSLO(raw map[string]interface{}, slo interface{ SetType(datadogV1.SLOType) }) (*utils.SLOSliSpecification, bool, error) {
+	if raw == nil || raw["type"] != string(sloTypeTimeSlice) {
+		return nil, false, nil
+	}
+	parsed := make(map[string]interface{}, len(raw))
+	for k, v := range raw {
+		parsed[k] = v
+	}
+	// Decode the SLO as a metric SLO, the SLI specification apart
+	delete(parsed, "sli_specification")
+	parsed["type"] = string(datadogV1.SLOTYPE_METRIC)
+	spec := &utils.SLOSliSpecification{}
+	if err := remarshal(raw["sli_specification"], spec); err != nil {
+		return nil, true, err
+	}
+	if err := remarshal(parsed, slo); err != nil {
+		return nil, true, err
+	}
+	if err := utils.CheckForUnparsed(slo); err != nil {
+		return nil, true, err
+	}
+	slo.SetType(sloTypeTimeSlice)
+	return spec, true, nil
+}
+
+// parseTimeSliceSLOListResponse decodes the time-slice SLO of a create or update response. As the type of a decoded
+// time-slice SLO isn't a valid enum value of the client, the response can't be checked for unparsed elements
+// afterwards: the other SLOs are checked here.
+func parseTimeSliceSLOListResponse(resp *datadogV1.SLOListResponse) (*utils.SLOSliSpecification, error) {
+	if len(resp.Data) == 0 {
+		return nil, utils.CheckForUnparsed(resp)
+	}
+	parsed := datadogV1.ServiceLevelObjective{}
+	spec, ok, err := parseTimeSliceSLO(resp.Data[0].UnparsedObject, &parsed)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, utils.CheckForUnparsed(resp)
+	}
+	resp.Data[0] = parsed
+	return spec, nil
+}
+
+// parseTimeSliceSLOsListResponse decodes the time-slice SLOs of a list response, without their SLI specification,
+// and checks the other SLOs for unparsed elements
+func parseTimeSliceSLOsListResponse(resp *datadogV1.SLOListResponse) error {
+	for i, slo := range resp.Data {
+		parsed := datadogV1.ServiceLevelObjective{}
+		_, ok, err := parseTimeSliceSLO(slo.UnparsedObject, &parsed)
+		if err != nil {
+			return err
+		}
+		if ok {
+			resp.Data[i] = parsed
+		} else if err := utils.CheckForUnparsed(slo); err != nil {
+			return err
+		}
+	}
+	if resp.Metadata != nil {
+		return utils.CheckForUnparsed(resp.Metadata)
+	}
+	return nil
+}
+
+// parseTimeSliceSLOResponse decodes the time-slice SLO of a get response, and checks the other SLOs for unparsed
+// elements
+func parseTimeSliceSLOResponse(resp *datadogV1.SLOResponse) (*utils.SLOSliSpecification, error) {
+	if resp.Data == nil {
+		return nil, utils.CheckForUnparsed(resp)
+	}
+	parsed := datadogV1.SLOResponseData{}
+	spec, ok, err := parseTimeSliceSLO(resp.Data.UnparsedObject, &parsed)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, utils.CheckForUnparsed(resp)
+	}
+	resp.Data = &parsed
+	resp.UnparsedObject = nil
+	return spec, nil
+}
+
+// remarshal decodes a generic JSON value into out
+func remarshal(in interface{}, out interface{}) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
 func buildServiceLevelObjectiveStructs(d *schema.ResourceData) (*datadogV1.ServiceLevelObjective, *datadogV1.ServiceLevelObjectiveRequest) {
 
 	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
@@ -251,6 +558,10 @@ func buildServiceLevelObjectiveStructs(d *schema.ResourceData) (*datadogV1.Servi
 			slo.SetGroups(s)
 			slor.SetGroups(s)
 		}
+	case sloTypeTimeSlice:
+		spec := buildSLOSliSpecification(d)
+		slo.AdditionalProperties = map[string]interface{}{"sli_specification": spec}
+		slor.AdditionalProperties = map[string]interface{}{"sli_specification": spec}
 	default:
 		// metric type
 		if attr, ok := d.GetOk("query"); ok {
@@ -360,27 +671,31 @@ func floatOk(val interface{}) (float64, bool) {
 func resourceDatadogServiceLevelObjectiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	_, slor := buildServiceLevelObjectiveStructs(d)
+	if tags := mergeDefaultTags(meta, slor.GetTags()); len(tags) > 0 {
+		slor.SetTags(tags)
+	}
 	sloResp, httpResponse, err := apiInstances.GetServiceLevelObjectivesApiV1().CreateSLO(auth, *slor)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error creating service level objective")
 	}
-	if err := utils.CheckForUnparsed(sloResp); err != nil {
+	spec, err := parseTimeSliceSLOListResponse(&sloResp)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	slo := &sloResp.GetData()[0]
 	d.SetId(slo.GetId())
 
-	return updateSLOState(d, slo)
+	return updateSLOState(d, meta, slo, spec)
 }
 
 func resourceDatadogServiceLevelObjectiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	sloResp, httpresp, err := apiInstances.GetServiceLevelObjectivesApiV1().GetSLO(auth, d.Id())
 	if err != nil {
@@ -390,14 +705,15 @@ func resourceDatadogServiceLevelObjectiveRead(ctx context.Context, d *schema.Res
 		}
 		return utils.TranslateClientErrorDiag(err, httpresp, "error getting service level objective")
 	}
-	if err := utils.CheckForUnparsed(sloResp); err != nil {
+	spec, err := parseTimeSliceSLOResponse(&sloResp)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return updateSLOStateFromRead(d, sloResp.Data)
+	return updateSLOStateFromRead(d, meta, sloResp.Data, spec)
 }
 
-func updateSLOState(d *schema.ResourceData, slo *datadogV1.ServiceLevelObjective) diag.Diagnostics {
+func updateSLOState(d *schema.ResourceData, meta interface{}, slo *datadogV1.ServiceLevelObjective, spec *utils.SLOSliSpecification) diag.Diagnostics {
 	thresholds := make([]map[string]interface{}, 0)
 	for _, threshold := range slo.GetThresholds() {
 		t := map[string]interface{}{
@@ -428,7 +744,7 @@ func updateSLOState(d *schema.ResourceData, slo *datadogV1.ServiceLevelObjective
 	if err := d.Set("type", slo.GetType()); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("tags", tags); err != nil {
+	if err := setTagsState(d, meta, tags); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("thresholds", thresholds); err != nil {
@@ -460,6 +776,10 @@ func updateSLOState(d *schema.ResourceData, slo *datadogV1.ServiceLevelObjective
 		if err := d.Set("groups", slo.GetGroups()); err != nil {
 			return diag.FromErr(err)
 		}
+	case sloTypeTimeSlice:
+		if err := d.Set("sli_specification", buildSLOSliSpecificationState(spec)); err != nil {
+			return diag.FromErr(err)
+		}
 	default:
 		// metric type
 		query := make(map[string]interface{})
@@ -474,7 +794,7 @@ func updateSLOState(d *schema.ResourceData, slo *datadogV1.ServiceLevelObjective
 }
 
 // This duplicates updateSLOState for the SLOResponseData structure, which has mostly the same interface
-func updateSLOStateFromRead(d *schema.ResourceData, slo *datadogV1.SLOResponseData) diag.Diagnostics {
+func updateSLOStateFromRead(d *schema.ResourceData, meta interface{}, slo *datadogV1.SLOResponseData, spec *utils.SLOSliSpecification) diag.Diagnostics {
 	thresholds := make([]map[string]interface{}, 0)
 	for _, threshold := range slo.GetThresholds() {
 		t := map[string]interface{}{
@@ -505,7 +825,7 @@ func updateSLOStateFromRead(d *schema.ResourceData, slo *datadogV1.SLOResponseDa
 	if err := d.Set("type", slo.GetType()); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("tags", tags); err != nil {
+	if err := setTagsState(d, meta, tags); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("thresholds", thresholds); err != nil {
@@ -537,6 +857,10 @@ func updateSLOStateFromRead(d *schema.ResourceData, slo *datadogV1.SLOResponseDa
 		if err := d.Set("groups", slo.GetGroups()); err != nil {
 			return diag.FromErr(err)
 		}
+	case sloTypeTimeSlice:
+		if err := d.Set("sli_specification", buildSLOSliSpecificationState(spec)); err != nil {
+			return diag.FromErr(err)
+		}
 	default:
 		// metric type
 		query := make(map[string]interface{})
@@ -553,24 +877,28 @@ func updateSLOStateFromRead(d *schema.ResourceData, slo *datadogV1.SLOResponseDa
 func resourceDatadogServiceLevelObjectiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	slo, _ := buildServiceLevelObjectiveStructs(d)
+	if tags := mergeDefaultTags(meta, slo.GetTags()); len(tags) > 0 {
+		slo.SetTags(tags)
+	}
 
 	updatedSLO, httpResponse, err := apiInstances.GetServiceLevelObjectivesApiV1().UpdateSLO(auth, d.Id(), *slo)
 	if err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error updating service level objective")
 	}
-	if err := utils.CheckForUnparsed(updatedSLO); err != nil {
+	spec, err := parseTimeSliceSLOListResponse(&updatedSLO)
+	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return updateSLOState(d, &updatedSLO.GetData()[0])
+	return updateSLOState(d, meta, &updatedSLO.GetData()[0], spec)
 }
 
 func resourceDatadogServiceLevelObjectiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 	var err error
 
 	var httpResponse *http.Response
@@ -0,0 +1,495 @@
+package datadog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+)
+
+func resourceDatadogServiceLevelObjectiveAlert() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog service level objective alert resource. This can be used to create and manage the burn rate and error budget monitors of a service level objective. The resource ID is the comma-separated list of the IDs of its monitors.",
+		CreateContext: resourceDatadogServiceLevelObjectiveAlertCreate,
+		ReadContext:   resourceDatadogServiceLevelObjectiveAlertRead,
+		UpdateContext: resourceDatadogServiceLevelObjectiveAlertUpdate,
+		DeleteContext: resourceDatadogServiceLevelObjectiveAlertDelete,
+		CustomizeDiff: resourceDatadogServiceLevelObjectiveAlertCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"slo_id": {
+				Description: "The ID of the service level objective to alert on. Only metric-based service level objectives are supported.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"timeframe": {
+				Description:      "The time frame of the service level objective target to alert on. It must be one of the time frames of the service level objective `thresholds`.",
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewSLOTimeframeFromValue),
+			},
+			"name": {
+				Description: "Name of the monitors. It is suffixed with the windows or threshold of each alert, e.g. `(burn rate 1h/5m)`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"message": {
+				Description:  "A message to include with notifications for the monitors. Exactly one of `message` or `notification` must be set, `notification` is rendered into this field.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"message", "notification"},
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"notification": getMonitorNotificationSchema(),
+			"escalation_message": {
+				Description: "A message to include with a re-notification. Computed from `notification` when its `escalation` block is set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				StateFunc: func(val interface{}) string {
+					return strings.TrimSpace(val.(string))
+				},
+			},
+			"priority": {
+				Description: "Integer from 1 (high) to 5 (low) indicating alert severity.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"renotify_interval": {
+				Description: "The number of minutes after the last notification before the monitors will re-notify on the current status. They will only re-notify if they're not resolved.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"tags": {
+				Description: "A list of tags to associate with the monitors.",
+				// we use TypeSet to represent tags, paradoxically to be able to maintain them ordered;
+				// we order them explicitly in the read/create/update methods of this resource and using
+				// TypeSet makes Terraform ignore differences in order when creating a plan
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags_all": getTagsAllSchema(),
+			"burn_rate": {
+				Description:  "A burn rate alert, triggered when the error budget is consumed faster than `threshold` times the sustainable rate over both the long and the short windows.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"burn_rate", "error_budget"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"long_window": {
+							Description:  "The long window of the alert, e.g. `1h`. It must be at most `48h` and shorter than `timeframe`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSLOAlertWindow,
+						},
+						"short_window": {
+							Description:  "The short window of the alert, e.g. `5m`. It must be shorter than `long_window`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateSLOAlertWindow,
+						},
+						"threshold": {
+							Description:  "The burn rate triggering the alert. It must be lower than the highest burn rate of the target, i.e. `100 / (100 - target)`.",
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+						},
+						"monitor_id": {
+							Description: "The ID of the monitor of the alert.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"error_budget": {
+				Description: "An error budget alert, triggered when the percentage of the error budget consumed over `timeframe` exceeds `threshold`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"threshold": {
+							Description:  "The percentage of the error budget consumed triggering the alert, in `(0,100]`.",
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: validation.FloatBetween(0, 100),
+						},
+						"monitor_id": {
+							Description: "The ID of the monitor of the alert.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"validate": {
+				Description: "If set to `false`, skip the validation of the windows and thresholds against the service level objective and of the notification handles during plan.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func validateSLOAlertWindow(val interface{}, key string) ([]string, []error) {
+	if _, err := utils.ParseSLOAlertWindow(val.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: %v", key, err)}
+	}
+	return nil, nil
+}
+
+func resourceDatadogServiceLevelObjectiveAlertCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := customizeDiffTagsAll(ctx, diff, meta); err != nil {
+		return err
+	}
+	if err := customizeDiffMonitorNotification(ctx, diff, meta); err != nil {
+		return err
+	}
+	return validateSLOAlertDiff(ctx, diff, meta)
+}
+
+// validateSLOAlertDiff checks the burn rate windows against the timeframe and, unless `validate` is false,
+// the timeframe and burn rate thresholds against the targets of the service level objective
+func validateSLOAlertDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	timeframe := diff.Get("timeframe").(string)
+	var errs []string
+	var burnRates []float64
+	for i := range diff.Get("burn_rate").([]interface{}) {
+		prefix := fmt.Sprintf("burn_rate.%d.", i)
+		if diff.NewValueKnown(prefix+"long_window") && diff.NewValueKnown(prefix+"short_window") {
+			if err := utils.ValidateBurnRateWindows(timeframe, diff.Get(prefix+"long_window").(string), diff.Get(prefix+"short_window").(string)); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		if diff.NewValueKnown(prefix + "threshold") {
+			burnRates = append(burnRates, diff.Get(prefix+"threshold").(float64))
+		}
+	}
+
+	if validate, ok := diff.GetOkExists("validate"); (!ok || validate.(bool)) && diff.NewValueKnown("slo_id") && diff.NewValueKnown("timeframe") {
+		providerConf := meta.(*ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.AuthContext(ctx)
+
+		slo, httpresp, err := apiInstances.GetServiceLevelObjectivesApiV1().GetSLO(auth, diff.Get("slo_id").(string))
+		if err != nil {
+			return utils.TranslateClientError(err, httpresp, "error getting service level objective")
+		}
+		if _, err := parseTimeSliceSLOResponse(&slo); err != nil {
+			return err
+		}
+		data := slo.GetData()
+		if data.GetType() != datadogV1.SLOTYPE_METRIC {
+			errs = append(errs, fmt.Sprintf("service level objective %s is of type %s, only metric service level objectives support alerts", data.GetId(), data.GetType()))
+		}
+		found := false
+		for _, threshold := range data.GetThresholds() {
+			if string(threshold.GetTimeframe()) != timeframe {
+				continue
+			}
+			found = true
+			maxBurnRate := utils.MaxSLOBurnRate(threshold.GetTarget())
+			for _, burnRate := range burnRates {
+				if burnRate >= maxBurnRate {
+					errs = append(errs, fmt.Sprintf("burn rate threshold %v must be lower than %v, the highest burn rate of the %v%% target", burnRate, maxBurnRate, threshold.GetTarget()))
+				}
+			}
+		}
+		if !found {
+			errs = append(errs, fmt.Sprintf("service level objective %s has no target over %s", data.GetId(), timeframe))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid service level objective alert: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// sloAlertBlocks maps the kind of alert to its block
+var sloAlertBlocks = []struct {
+	kind  utils.SLOAlertKind
+	block string
+}{
+	{utils.SLOAlertBurnRate, "burn_rate"},
+	{utils.SLOAlertErrorBudget, "error_budget"},
+}
+
+func buildSLOAlertQuery(d *schema.ResourceData, kind utils.SLOAlertKind, block map[string]interface{}) utils.SLOAlertQuery {
+	q := utils.SLOAlertQuery{
+		Kind:      kind,
+		SLOID:     d.Get("slo_id").(string),
+		Timeframe: d.Get("timeframe").(string),
+		Threshold: block["threshold"].(float64),
+	}
+	if kind == utils.SLOAlertBurnRate {
+		q.LongWindow = block["long_window"].(string)
+		q.ShortWindow = block["short_window"].(string)
+	}
+	return q
+}
+
+// sloAlertMonitorNameSuffix returns the suffix appended to the `name` of the resource for the monitor of an alert
+func sloAlertMonitorNameSuffix(q utils.SLOAlertQuery) string {
+	if q.Kind == utils.SLOAlertErrorBudget {
+		return fmt.Sprintf(" (%v%% of error budget)", q.Threshold)
+	}
+	return fmt.Sprintf(" (burn rate %s/%s)", q.LongWindow, q.ShortWindow)
+}
+
+func buildSLOAlertMonitorStructs(d *schema.ResourceData, meta interface{}, q utils.SLOAlertQuery) (*datadogV1.Monitor, *datadogV1.MonitorUpdateRequest) {
+	name := d.Get("name").(string) + sloAlertMonitorNameSuffix(q)
+	message := d.Get("message").(string)
+
+	o := datadogV1.MonitorOptions{}
+	thresholds := datadogV1.MonitorThresholds{}
+	thresholds.SetCritical(q.Threshold)
+	o.SetThresholds(thresholds)
+	if attr, ok := d.GetOk("escalation_message"); ok {
+		o.SetEscalationMessage(attr.(string))
+	}
+	if attr, ok := d.GetOk("renotify_interval"); ok {
+		o.SetRenotifyInterval(int64(attr.(int)))
+	}
+
+	tags := make([]string, 0)
+	if attr, ok := d.GetOk("tags"); ok {
+		for _, s := range attr.(*schema.Set).List() {
+			tags = append(tags, s.(string))
+		}
+	}
+	tags = mergeMonitorDefaultTags(meta, tags)
+
+	m := datadogV1.NewMonitor(q.Query(), datadogV1.MONITORTYPE_SLO_ALERT)
+	m.SetName(name)
+	m.SetMessage(message)
+	m.SetPriority(int64(d.Get("priority").(int)))
+	m.SetOptions(o)
+	m.SetTags(tags)
+
+	u := datadogV1.NewMonitorUpdateRequest()
+	u.SetType(datadogV1.MONITORTYPE_SLO_ALERT)
+	u.SetQuery(q.Query())
+	u.SetName(name)
+	u.SetMessage(message)
+	u.SetPriority(int64(d.Get("priority").(int)))
+	u.SetOptions(o)
+	u.SetTags(tags)
+
+	return m, u
+}
+
+func resourceDatadogServiceLevelObjectiveAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applySLOAlertMonitors(ctx, d, meta)
+}
+
+func resourceDatadogServiceLevelObjectiveAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return applySLOAlertMonitors(ctx, d, meta)
+}
+
+// applySLOAlertMonitors creates or updates the monitor of each alert block and deletes the monitors of the
+// removed blocks. Monitors are matched to blocks by position, the resource ID is updated as soon as a monitor
+// is created or deleted so that no monitor is lost on errors.
+func applySLOAlertMonitors(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	ids := make(map[utils.SLOAlertKind][]int64)
+	setID := func(pending ...int64) {
+		var all []string
+		for _, b := range sloAlertBlocks {
+			for _, id := range ids[b.kind] {
+				all = append(all, strconv.FormatInt(id, 10))
+			}
+		}
+		for _, id := range pending {
+			all = append(all, strconv.FormatInt(id, 10))
+		}
+		d.SetId(strings.Join(all, ","))
+	}
+
+	// Keep track of the monitors not yet reconciled, so that the ID still references them on errors
+	var pending []int64
+	oldBlocks := make(map[utils.SLOAlertKind][]interface{})
+	for _, b := range sloAlertBlocks {
+		old, _ := d.GetChange(b.block)
+		oldBlocks[b.kind] = old.([]interface{})
+		for _, block := range oldBlocks[b.kind] {
+			if id := block.(map[string]interface{})["monitor_id"].(int); id != 0 {
+				pending = append(pending, int64(id))
+			}
+		}
+	}
+	removePending := func(id int64) {
+		for i, p := range pending {
+			if p == id {
+				pending = append(pending[:i], pending[i+1:]...)
+				return
+			}
+		}
+	}
+
+	for _, b := range sloAlertBlocks {
+		blocks := d.Get(b.block).([]interface{})
+		for i, block := range blocks {
+			q := buildSLOAlertQuery(d, b.kind, block.(map[string]interface{}))
+			m, u := buildSLOAlertMonitorStructs(d, meta, q)
+			var oldID int64
+			if i < len(oldBlocks[b.kind]) {
+				oldID = int64(oldBlocks[b.kind][i].(map[string]interface{})["monitor_id"].(int))
+			}
+			if oldID != 0 {
+				if _, httpresp, err := apiInstances.GetMonitorsApiV1().UpdateMonitor(auth, oldID, *u); err != nil {
+					setID(pending...)
+					return utils.TranslateClientErrorDiag(err, httpresp, "error updating service level objective alert monitor")
+				}
+				removePending(oldID)
+				ids[b.kind] = append(ids[b.kind], oldID)
+				continue
+			}
+			mCreated, httpresp, err := apiInstances.GetMonitorsApiV1().CreateMonitor(auth, *m)
+			if err != nil {
+				setID(pending...)
+				return utils.TranslateClientErrorDiag(err, httpresp, "error creating service level objective alert monitor")
+			}
+			ids[b.kind] = append(ids[b.kind], mCreated.GetId())
+		}
+	}
+
+	// The remaining monitors belong to removed blocks
+	for len(pending) > 0 {
+		id := pending[0]
+		if _, httpresp, err := apiInstances.GetMonitorsApiV1().DeleteMonitor(auth, id); err != nil && (httpresp == nil || httpresp.StatusCode != 404) {
+			setID(pending...)
+			return utils.TranslateClientErrorDiag(err, httpresp, "error deleting service level objective alert monitor")
+		}
+		pending = pending[1:]
+	}
+	setID()
+
+	return resourceDatadogServiceLevelObjectiveAlertRead(ctx, d, meta)
+}
+
+func resourceDatadogServiceLevelObjectiveAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	var (
+		first  *datadogV1.Monitor
+		firstQ utils.SLOAlertQuery
+		found  []string
+		blocks = make(map[utils.SLOAlertKind][]interface{})
+	)
+	for _, id := range strings.Split(d.Id(), ",") {
+		i, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			return diag.Errorf("invalid service level objective alert ID %q, expected a comma-separated list of monitor IDs", d.Id())
+		}
+		m, httpresp, err := apiInstances.GetMonitorsApiV1().GetMonitor(auth, i)
+		if err != nil {
+			if httpresp != nil && httpresp.StatusCode == 404 {
+				// The block of a deleted monitor is removed from the state, the monitor is created again on the next apply
+				continue
+			}
+			return utils.TranslateClientErrorDiag(err, httpresp, "error getting service level objective alert monitor")
+		}
+		if err := utils.CheckForUnparsed(m); err != nil {
+			return diag.FromErr(err)
+		}
+		q, ok := utils.ParseSLOAlertQuery(m.GetQuery())
+		if !ok {
+			return diag.Errorf("monitor %d isn't a service level objective burn rate or error budget alert", i)
+		}
+		if first == nil {
+			first, firstQ = &m, q
+		}
+		found = append(found, strconv.FormatInt(i, 10))
+
+		block := map[string]interface{}{
+			"threshold":  q.Threshold,
+			"monitor_id": int(i),
+		}
+		if q.Kind == utils.SLOAlertBurnRate {
+			block["long_window"] = q.LongWindow
+			block["short_window"] = q.ShortWindow
+		}
+		blocks[q.Kind] = append(blocks[q.Kind], block)
+	}
+	if first == nil {
+		d.SetId("")
+		return nil
+	}
+	d.SetId(strings.Join(found, ","))
+
+	if err := d.Set("slo_id", firstQ.SLOID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("timeframe", firstQ.Timeframe); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", strings.TrimSuffix(first.GetName(), sloAlertMonitorNameSuffix(firstQ))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", first.GetMessage()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("escalation_message", first.Options.GetEscalationMessage()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("priority", first.GetPriority()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("renotify_interval", first.Options.GetRenotifyInterval()); err != nil {
+		return diag.FromErr(err)
+	}
+	tags := first.GetTags()
+	sort.Strings(tags)
+	if err := setTagsState(d, meta, tags); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, b := range sloAlertBlocks {
+		if err := d.Set(b.block, blocks[b.kind]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceDatadogServiceLevelObjectiveAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	for _, id := range strings.Split(d.Id(), ",") {
+		i, err := strconv.ParseInt(strings.TrimSpace(id), 10, 64)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if _, httpresp, err := apiInstances.GetMonitorsApiV1().DeleteMonitor(auth, i); err != nil {
+			if httpresp != nil && httpresp.StatusCode == 404 {
+				continue
+			}
+			return utils.TranslateClientErrorDiag(err, httpresp, "error deleting service level objective alert monitor")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,422 @@
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const sharedDashboardPath = "/api/v1/dashboard/public"
+
+// sharedDashboardResponse is the part of the shared dashboard API response used by the resource. The API client
+// doesn't include the shared dashboard API yet, so the requests are sent with `utils.SendRequest`.
+type sharedDashboardResponse struct {
+	Token       string `json:"token"`
+	DashboardId string `json:"dashboard_id"`
+	ShareType   string `json:"share_type"`
+	GlobalTime  *struct {
+		LiveSpan string `json:"live_span"`
+	} `json:"global_time"`
+	GlobalTimeSelectableEnabled bool `json:"global_time_selectable_enabled"`
+	SelectableTemplateVars      []struct {
+		Name         string   `json:"name"`
+		Prefix       string   `json:"prefix"`
+		DefaultValue string   `json:"default_value"`
+		VisibleTags  []string `json:"visible_tags"`
+	} `json:"selectable_template_vars"`
+	ShareList  []string `json:"share_list"`
+	Expiration *string  `json:"expiration"`
+	PublicUrl  string   `json:"public_url"`
+}
+
+// sharedDashboardInvitationsResponse is the part of the shared dashboard invitations API response used by the resource
+type sharedDashboardInvitationsResponse struct {
+	Data []struct {
+		Attributes struct {
+			Email            string  `json:"email"`
+			AccessExpiration *string `json:"access_expiration"`
+			InvitationExpiry *string `json:"invitation_expiry"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+func resourceDatadogSharedDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Provides a Datadog shared dashboard resource. This can be used to share a dashboard publicly, with a list of invitees, or as an embed.",
+		CreateContext: resourceDatadogSharedDashboardCreate,
+		ReadContext:   resourceDatadogSharedDashboardRead,
+		UpdateContext: resourceDatadogSharedDashboardUpdate,
+		DeleteContext: resourceDatadogSharedDashboardDelete,
+		CustomizeDiff: validateSharedDashboardDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"dashboard_id": {
+				Description: "The ID of the dashboard to share.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"share_type": {
+				Description:      "The type of the share. `open` shares are public, `invite` shares are only visible by the emails of `share_list`, and `embed` shares can be embedded in other websites.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "open",
+				ValidateDiagFunc: validators.ValidateStringEnumValue("open", "invite", "embed"),
+			},
+			"global_time": {
+				Description: "The default timeframe of the shared dashboard.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"live_span": {
+							Description:      "The timeframe to use when displaying the dashboard.",
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validators.ValidateEnumValue(datadogV1.NewWidgetLiveSpanFromValue),
+						},
+					},
+				},
+			},
+			"global_time_selectable": {
+				Description: "Whether viewers can change the timeframe of the shared dashboard.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"selectable_template_var": {
+				Description: "The template variables viewers can change.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the template variable of the dashboard.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"prefix": {
+							Description: "The tag prefix of the template variable.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"default_value": {
+							Description: "The default value of the template variable.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+						"visible_tags": {
+							Description: "The tag values viewers can select, all of them when empty.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"share_list": {
+				Description: "The emails of the invitees, only for `invite` shares.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration": {
+				Description:      "The time the share expires at, in RFC3339 format. The share doesn't expire when not set.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentSharedDashboardExpiration,
+			},
+			"invitee": {
+				Description: "The invitations sent to the emails of `share_list`.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Description: "The email of the invitee.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"access_expiration": {
+							Description: "The time the access of the invitee expires at, empty when the invitation wasn't accepted yet.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"invitation_expiration": {
+							Description: "The time the invitation expires at.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"token": {
+				Description: "The token of the share.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"public_url": {
+				Description: "The URL of the shared dashboard.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validateSharedDashboardDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("share_type") || !diff.NewValueKnown("share_list") {
+		return nil
+	}
+	if shareType := diff.Get("share_type").(string); shareType != "invite" && diff.Get("share_list").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("share_list can only be set for `invite` shares, not `%s` ones", shareType)
+	}
+	return nil
+}
+
+// suppressEquivalentSharedDashboardExpiration ignores the formatting differences of the expiration returned by the
+// API, e.g. `2050-01-01T00:00:00Z` and `2050-01-01T00:00:00+00:00`
+func suppressEquivalentSharedDashboardExpiration(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	return err == nil && oldTime.Equal(newTime)
+}
+
+func buildSharedDashboardAttributes(d *schema.ResourceData) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"share_type":                     d.Get("share_type"),
+		"global_time_selectable_enabled": d.Get("global_time_selectable"),
+		// Null values clear the global time, the template variables and the expiration
+		"global_time":              nil,
+		"selectable_template_vars": nil,
+		"expiration":               nil,
+	}
+	if v, ok := d.GetOk("expiration"); ok {
+		attributes["expiration"] = v
+	}
+	if v, ok := d.GetOk("global_time.0.live_span"); ok {
+		attributes["global_time"] = map[string]interface{}{"live_span": v}
+	}
+
+	var templateVars []map[string]interface{}
+	for _, v := range d.Get("selectable_template_var").([]interface{}) {
+		templateVar := v.(map[string]interface{})
+		templateVars = append(templateVars, map[string]interface{}{
+			"name":          templateVar["name"],
+			"prefix":        templateVar["prefix"],
+			"default_value": templateVar["default_value"],
+			"visible_tags":  templateVar["visible_tags"],
+		})
+	}
+	if len(templateVars) > 0 {
+		attributes["selectable_template_vars"] = templateVars
+	}
+
+	shareList := []string{}
+	for _, email := range d.Get("share_list").(*schema.Set).List() {
+		shareList = append(shareList, email.(string))
+	}
+	attributes["share_list"] = shareList
+	return attributes
+}
+
+func resourceDatadogSharedDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	dashboardID := d.Get("dashboard_id").(string)
+	dashboard, httpresp, err := apiInstances.GetDashboardsApiV1().GetDashboard(auth, dashboardID)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting dashboard")
+	}
+
+	body := buildSharedDashboardAttributes(d)
+	body["dashboard_id"] = dashboardID
+	body["dashboard_type"] = dashboardListItemType(string(dashboard.GetLayoutType()))
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "POST", sharedDashboardPath, &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error creating shared dashboard")
+	}
+
+	var resp sharedDashboardResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(resp.Token)
+
+	if diags := updateSharedDashboardState(d, &resp); diags.HasError() {
+		return diags
+	}
+	return updateSharedDashboardInviteesState(ctx, d, providerConf)
+}
+
+func resourceDatadogSharedDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", sharedDashboardPath+"/"+d.Id(), nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error getting shared dashboard")
+	}
+
+	var resp sharedDashboardResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updateSharedDashboardState(d, &resp); diags.HasError() {
+		return diags
+	}
+	return updateSharedDashboardInviteesState(ctx, d, providerConf)
+}
+
+func resourceDatadogSharedDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	body := buildSharedDashboardAttributes(d)
+	respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "PUT", sharedDashboardPath+"/"+d.Id(), &body)
+	if err != nil {
+		return utils.TranslateClientErrorDiag(err, httpresp, "error updating shared dashboard")
+	}
+
+	var resp sharedDashboardResponse
+	if err := json.Unmarshal(respByte, &resp); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if diags := updateSharedDashboardState(d, &resp); diags.HasError() {
+		return diags
+	}
+	return updateSharedDashboardInviteesState(ctx, d, providerConf)
+}
+
+func resourceDatadogSharedDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	providerConf := meta.(*ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.AuthContext(ctx)
+
+	_, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "DELETE", sharedDashboardPath+"/"+d.Id(), nil)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			return nil
+		}
+		return utils.TranslateClientErrorDiag(err, httpresp, "error deleting shared dashboard")
+	}
+
+	return nil
+}
+
+// updateSharedDashboardState sets the share settings. `share_list` is set from the API, so invitees added or
+// removed outside of Terraform show up in plans.
+func updateSharedDashboardState(d *schema.ResourceData, resp *sharedDashboardResponse) diag.Diagnostics {
+	if err := d.Set("dashboard_id", resp.DashboardId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("share_type", resp.ShareType); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var globalTime []map[string]interface{}
+	if resp.GlobalTime != nil && resp.GlobalTime.LiveSpan != "" {
+		globalTime = append(globalTime, map[string]interface{}{"live_span": resp.GlobalTime.LiveSpan})
+	}
+	if err := d.Set("global_time", globalTime); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("global_time_selectable", resp.GlobalTimeSelectableEnabled); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var templateVars []map[string]interface{}
+	for _, templateVar := range resp.SelectableTemplateVars {
+		templateVars = append(templateVars, map[string]interface{}{
+			"name":          templateVar.Name,
+			"prefix":        templateVar.Prefix,
+			"default_value": templateVar.DefaultValue,
+			"visible_tags":  templateVar.VisibleTags,
+		})
+	}
+	if err := d.Set("selectable_template_var", templateVars); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("share_list", resp.ShareList); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var expiration string
+	if resp.Expiration != nil {
+		expiration = *resp.Expiration
+	}
+	if err := d.Set("expiration", expiration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("token", resp.Token); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("public_url", resp.PublicUrl); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// updateSharedDashboardInviteesState sets the invitations of `invite` shares
+func updateSharedDashboardInviteesState(ctx context.Context, d *schema.ResourceData, providerConf *ProviderConfiguration) diag.Diagnostics {
+	var invitees []map[string]interface{}
+	if d.Get("share_type").(string) == "invite" {
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.AuthContext(ctx)
+
+		respByte, httpresp, err := utils.SendRequest(auth, apiInstances.HttpClient, "GET", sharedDashboardPath+"/"+d.Id()+"/invitation", nil)
+		if err != nil {
+			return utils.TranslateClientErrorDiag(err, httpresp, "error getting shared dashboard invitations")
+		}
+
+		var resp sharedDashboardInvitationsResponse
+		if err := json.Unmarshal(respByte, &resp); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, invitation := range resp.Data {
+			invitee := map[string]interface{}{
+				"email": invitation.Attributes.Email,
+			}
+			if v := invitation.Attributes.AccessExpiration; v != nil {
+				invitee["access_expiration"] = *v
+			}
+			if v := invitation.Attributes.InvitationExpiry; v != nil {
+				invitee["invitation_expiration"] = *v
+			}
+			invitees = append(invitees, invitee)
+		}
+	}
+
+	if err := d.Set("invitee", invitees); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -74,7 +74,7 @@ func syntheticsPrivateLocationMetadata() map[string]*schema.Schema {
 func resourceDatadogSyntheticsPrivateLocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	syntheticsPrivateLocation := buildSyntheticsPrivateLocationStruct(d)
 	createdSyntheticsPrivateLocationResponse, httpResponse, err := apiInstances.GetSyntheticsApiV1().CreatePrivateLocation(auth, *syntheticsPrivateLocation)
@@ -120,7 +120,7 @@ func resourceDatadogSyntheticsPrivateLocationCreate(ctx context.Context, d *sche
 func resourceDatadogSyntheticsPrivateLocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	syntheticsPrivateLocation, httpresp, err := apiInstances.GetSyntheticsApiV1().GetPrivateLocation(auth, d.Id())
 
@@ -142,7 +142,7 @@ func resourceDatadogSyntheticsPrivateLocationRead(ctx context.Context, d *schema
 func resourceDatadogSyntheticsPrivateLocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	syntheticsPrivateLocation := buildSyntheticsPrivateLocationStruct(d)
 	if _, httpResponse, err := apiInstances.GetSyntheticsApiV1().UpdatePrivateLocation(auth, d.Id(), *syntheticsPrivateLocation); err != nil {
@@ -157,7 +157,7 @@ func resourceDatadogSyntheticsPrivateLocationUpdate(ctx context.Context, d *sche
 func resourceDatadogSyntheticsPrivateLocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetSyntheticsApiV1().DeletePrivateLocation(auth, d.Id()); err != nil {
 		// The resource is assumed to still exist, and all prior state is preserved.
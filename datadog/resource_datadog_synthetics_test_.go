@@ -29,6 +29,7 @@ func resourceDatadogSyntheticsTest() *schema.Resource {
 		ReadContext:   resourceDatadogSyntheticsTestRead,
 		UpdateContext: resourceDatadogSyntheticsTestUpdate,
 		DeleteContext: resourceDatadogSyntheticsTestDelete,
+		CustomizeDiff: customizeDiffTagsAll,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -102,6 +103,7 @@ func resourceDatadogSyntheticsTest() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"tags_all": getTagsAllSchema(),
 			"status": {
 				Description:      "Define whether you want to start (`live`) or pause (`paused`) a Synthetic test.",
 				Type:             schema.TypeString,
@@ -1159,12 +1161,13 @@ func syntheticsFollowRedirectsOption() *schema.Schema {
 func resourceDatadogSyntheticsTestCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	testType := getSyntheticsTestType(d)
 
 	if testType == datadogV1.SYNTHETICSTESTDETAILSTYPE_API {
 		syntheticsTest := buildSyntheticsAPITestStruct(d)
+		syntheticsTest.SetTags(mergeDefaultTags(meta, syntheticsTest.GetTags()))
 		createdSyntheticsTest, httpResponseCreate, err := apiInstances.GetSyntheticsApiV1().CreateSyntheticsAPITest(auth, *syntheticsTest)
 		if err != nil {
 			// Note that Id won't be set, so no state will be saved.
@@ -1197,9 +1200,10 @@ func resourceDatadogSyntheticsTestCreate(ctx context.Context, d *schema.Resource
 
 		d.SetId(getSyntheticsApiTestResponse.GetPublicId())
 
-		return updateSyntheticsAPITestLocalState(d, &getSyntheticsApiTestResponse)
+		return updateSyntheticsAPITestLocalState(d, meta, &getSyntheticsApiTestResponse)
 	} else if testType == datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER {
 		syntheticsTest := buildSyntheticsBrowserTestStruct(d)
+		syntheticsTest.SetTags(mergeDefaultTags(meta, syntheticsTest.GetTags()))
 		createdSyntheticsTest, httpResponse, err := apiInstances.GetSyntheticsApiV1().CreateSyntheticsBrowserTest(auth, *syntheticsTest)
 		if err != nil {
 			// Note that Id won't be set, so no state will be saved.
@@ -1232,7 +1236,7 @@ func resourceDatadogSyntheticsTestCreate(ctx context.Context, d *schema.Resource
 
 		d.SetId(getSyntheticsBrowserTestResponse.GetPublicId())
 
-		return updateSyntheticsBrowserTestLocalState(d, &getSyntheticsBrowserTestResponse)
+		return updateSyntheticsBrowserTestLocalState(d, meta, &getSyntheticsBrowserTestResponse)
 	}
 
 	return diag.Errorf("unrecognized synthetics test type %v", testType)
@@ -1241,7 +1245,7 @@ func resourceDatadogSyntheticsTestCreate(ctx context.Context, d *schema.Resource
 func resourceDatadogSyntheticsTestRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	var syntheticsTest datadogV1.SyntheticsTestDetails
 	var syntheticsAPITest datadogV1.SyntheticsAPITest
@@ -1282,24 +1286,25 @@ func resourceDatadogSyntheticsTestRead(ctx context.Context, d *schema.ResourceDa
 		if err := utils.CheckForUnparsed(syntheticsBrowserTest); err != nil {
 			return diag.FromErr(err)
 		}
-		return updateSyntheticsBrowserTestLocalState(d, &syntheticsBrowserTest)
+		return updateSyntheticsBrowserTestLocalState(d, meta, &syntheticsBrowserTest)
 	}
 
 	if err := utils.CheckForUnparsed(syntheticsAPITest); err != nil {
 		return diag.FromErr(err)
 	}
-	return updateSyntheticsAPITestLocalState(d, &syntheticsAPITest)
+	return updateSyntheticsAPITestLocalState(d, meta, &syntheticsAPITest)
 }
 
 func resourceDatadogSyntheticsTestUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	testType := getSyntheticsTestType(d)
 
 	if testType == datadogV1.SYNTHETICSTESTDETAILSTYPE_API {
 		syntheticsTest := buildSyntheticsAPITestStruct(d)
+		syntheticsTest.SetTags(mergeDefaultTags(meta, syntheticsTest.GetTags()))
 		updatedTest, httpResponse, err := apiInstances.GetSyntheticsApiV1().UpdateAPITest(auth, d.Id(), *syntheticsTest)
 		if err != nil {
 			// If the Update callback returns with or without an error, the full state is saved.
@@ -1308,9 +1313,10 @@ func resourceDatadogSyntheticsTestUpdate(ctx context.Context, d *schema.Resource
 		if err := utils.CheckForUnparsed(updatedTest); err != nil {
 			return diag.FromErr(err)
 		}
-		return updateSyntheticsAPITestLocalState(d, &updatedTest)
+		return updateSyntheticsAPITestLocalState(d, meta, &updatedTest)
 	} else if testType == datadogV1.SYNTHETICSTESTDETAILSTYPE_BROWSER {
 		syntheticsTest := buildSyntheticsBrowserTestStruct(d)
+		syntheticsTest.SetTags(mergeDefaultTags(meta, syntheticsTest.GetTags()))
 		updatedTest, httpResponse, err := apiInstances.GetSyntheticsApiV1().UpdateBrowserTest(auth, d.Id(), *syntheticsTest)
 		if err != nil {
 			// If the Update callback returns with or without an error, the full state is saved.
@@ -1319,7 +1325,7 @@ func resourceDatadogSyntheticsTestUpdate(ctx context.Context, d *schema.Resource
 		if err := utils.CheckForUnparsed(updatedTest); err != nil {
 			return diag.FromErr(err)
 		}
-		return updateSyntheticsBrowserTestLocalState(d, &updatedTest)
+		return updateSyntheticsBrowserTestLocalState(d, meta, &updatedTest)
 	}
 
 	return diag.Errorf("unrecognized synthetics test type %v", testType)
@@ -1328,7 +1334,7 @@ func resourceDatadogSyntheticsTestUpdate(ctx context.Context, d *schema.Resource
 func resourceDatadogSyntheticsTestDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	syntheticsDeleteTestsPayload := datadogV1.SyntheticsDeleteTestsPayload{PublicIds: []string{d.Id()}}
 	if _, httpResponse, err := apiInstances.GetSyntheticsApiV1().DeleteTests(auth, syntheticsDeleteTestsPayload); err != nil {
@@ -2545,7 +2551,7 @@ func buildLocalExtractedValues(extractedValues []datadogV1.SyntheticsParsingOpti
 	return localExtractedValues
 }
 
-func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, syntheticsTest *datadogV1.SyntheticsBrowserTest) diag.Diagnostics {
+func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, meta interface{}, syntheticsTest *datadogV1.SyntheticsBrowserTest) diag.Diagnostics {
 	if err := d.Set("type", syntheticsTest.GetType()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -2868,7 +2874,7 @@ func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, syntheticsTes
 	if err := d.Set("status", syntheticsTest.GetStatus()); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("tags", syntheticsTest.Tags); err != nil {
+	if err := setTagsState(d, meta, syntheticsTest.Tags); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("monitor_id", syntheticsTest.MonitorId); err != nil {
@@ -2877,7 +2883,7 @@ func updateSyntheticsBrowserTestLocalState(d *schema.ResourceData, syntheticsTes
 	return nil
 }
 
-func updateSyntheticsAPITestLocalState(d *schema.ResourceData, syntheticsTest *datadogV1.SyntheticsAPITest) diag.Diagnostics {
+func updateSyntheticsAPITestLocalState(d *schema.ResourceData, meta interface{}, syntheticsTest *datadogV1.SyntheticsAPITest) diag.Diagnostics {
 	if err := d.Set("type", syntheticsTest.GetType()); err != nil {
 		return diag.FromErr(err)
 	}
@@ -3188,7 +3194,7 @@ func updateSyntheticsAPITestLocalState(d *schema.ResourceData, syntheticsTest *d
 	if err := d.Set("status", syntheticsTest.GetStatus()); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := d.Set("tags", syntheticsTest.Tags); err != nil {
+	if err := setTagsState(d, meta, syntheticsTest.Tags); err != nil {
 		return diag.FromErr(err)
 	}
 	if err := d.Set("monitor_id", syntheticsTest.MonitorId); err != nil {
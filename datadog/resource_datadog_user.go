@@ -118,10 +118,10 @@ func buildDatadogUserV2UpdateStruct(d *schema.ResourceData, userID string) *data
 	return userRequest
 }
 
-func updateRoles(meta interface{}, userID string, oldRoles *schema.Set, newRoles *schema.Set) diag.Diagnostics {
+func updateRoles(ctx context.Context, meta interface{}, userID string, oldRoles *schema.Set, newRoles *schema.Set) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	rolesToRemove := oldRoles.Difference(newRoles)
 	rolesToAdd := newRoles.Difference(oldRoles)
@@ -155,7 +155,7 @@ func updateRoles(meta interface{}, userID string, oldRoles *schema.Set, newRoles
 func resourceDatadogUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	userRequest := buildDatadogUserV2Struct(d)
 	var userID string
@@ -215,7 +215,7 @@ func resourceDatadogUserCreate(ctx context.Context, d *schema.ResourceData, meta
 			oldRoles.Add(existingRole.GetId())
 		}
 
-		if err := updateRoles(meta, userID, oldRoles, newRoles); err != nil {
+		if err := updateRoles(ctx, meta, userID, oldRoles, newRoles); err != nil {
 			return err
 		}
 
@@ -233,7 +233,7 @@ func resourceDatadogUserCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	// Send invitation email to newly created users
 	if d.Get("send_user_invitation").(bool) {
-		if err := sendUserInvitation(userID, d, meta); err != nil {
+		if err := sendUserInvitation(ctx, userID, d, meta); err != nil {
 			return err
 		}
 	}
@@ -245,10 +245,10 @@ func resourceDatadogUserCreate(ctx context.Context, d *schema.ResourceData, meta
 	return updateUserStateV2(d, &createResponse)
 }
 
-func sendUserInvitation(userID string, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func sendUserInvitation(ctx context.Context, userID string, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	userInviteRelationData := datadogV2.NewRelationshipToUserDataWithDefaults()
 	userInviteRelationData.SetId(userID)
@@ -306,7 +306,7 @@ func updateUserStateV2(d *schema.ResourceData, user *datadogV2.UserResponse) dia
 func resourceDatadogUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	userResponse, httpResponse, err := apiInstances.GetUsersApiV2().GetUser(auth, d.Id())
 	if err != nil {
@@ -325,14 +325,14 @@ func resourceDatadogUserRead(ctx context.Context, d *schema.ResourceData, meta i
 func resourceDatadogUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if d.HasChange("roles") {
 		oldRolesI, newRolesI := d.GetChange("roles")
 		oldRoles := oldRolesI.(*schema.Set)
 		newRoles := newRolesI.(*schema.Set)
 
-		if err := updateRoles(meta, d.Id(), oldRoles, newRoles); err != nil {
+		if err := updateRoles(ctx, meta, d.Id(), oldRoles, newRoles); err != nil {
 			return err
 		}
 	}
@@ -352,7 +352,7 @@ func resourceDatadogUserUpdate(ctx context.Context, d *schema.ResourceData, meta
 func resourceDatadogUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetUsersApiV2().DisableUser(auth, d.Id()); err != nil {
 		if httpResponse != nil && httpResponse.StatusCode == 404 {
@@ -93,7 +93,7 @@ func resourceDatadogWebhookCreate(ctx context.Context, d *schema.ResourceData, m
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	webhook, err := buildWebhookCreatePayload(d)
 	if err != nil {
@@ -113,7 +113,7 @@ func resourceDatadogWebhookCreate(ctx context.Context, d *schema.ResourceData, m
 func resourceDatadogWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().GetWebhooksIntegration(auth, d.Id())
 	if err != nil {
@@ -132,7 +132,7 @@ func resourceDatadogWebhookUpdate(ctx context.Context, d *schema.ResourceData, m
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	webhook, err := buildWebhookUpdatePayload(d)
 	if err != nil {
@@ -155,7 +155,7 @@ func resourceDatadogWebhookDelete(ctx context.Context, d *schema.ResourceData, m
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().DeleteWebhooksIntegration(auth, d.Id()); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error deleting webhook")
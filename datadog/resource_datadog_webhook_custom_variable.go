@@ -65,7 +65,7 @@ func resourceDatadogWebhookCustomVariableCreate(ctx context.Context, d *schema.R
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().CreateWebhooksIntegrationCustomVariable(auth, datadogV1.WebhooksIntegrationCustomVariable{
 		Name:     d.Get("name").(string),
@@ -84,7 +84,7 @@ func resourceDatadogWebhookCustomVariableCreate(ctx context.Context, d *schema.R
 func resourceDatadogWebhookCustomVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().GetWebhooksIntegrationCustomVariable(auth, d.Id())
 	if err != nil {
@@ -103,7 +103,7 @@ func resourceDatadogWebhookCustomVariableUpdate(ctx context.Context, d *schema.R
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	resp, httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().UpdateWebhooksIntegrationCustomVariable(auth, d.Id(), datadogV1.WebhooksIntegrationCustomVariableUpdateRequest{
 		Name:     datadog.PtrString(d.Get("name").(string)),
@@ -125,7 +125,7 @@ func resourceDatadogWebhookCustomVariableDelete(ctx context.Context, d *schema.R
 
 	providerConf := meta.(*ProviderConfiguration)
 	apiInstances := providerConf.DatadogApiInstances
-	auth := providerConf.Auth
+	auth := providerConf.AuthContext(ctx)
 
 	if httpResponse, err := apiInstances.GetWebhooksIntegrationApiV1().DeleteWebhooksIntegrationCustomVariable(auth, d.Id()); err != nil {
 		return utils.TranslateClientErrorDiag(err, httpResponse, "error deleting webhooks custom variable")
@@ -0,0 +1,101 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The dashboard widget tests are offline: the data source doesn't send any request, and the dashboards using its
+// JSON are created against the in-memory dashboard API of the widget round trip tests.
+
+func readDashboardWidgetDatasource(t *testing.T, config map[string]interface{}) *schema.ResourceData {
+	dataSource := datadog.Provider().DataSourcesMap["datadog_dashboard_widget"]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, config)
+	if diags := dataSource.ReadContext(context.Background(), d, nil); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	return d
+}
+
+func TestDatadogDashboardWidgetDatasource(t *testing.T) {
+	d := readDashboardWidgetDatasource(t, map[string]interface{}{
+		"widget_layout": []interface{}{map[string]interface{}{"x": 0, "y": 0, "width": 4, "height": 2}},
+		"free_text_definition": []interface{}{map[string]interface{}{
+			"text":       "Checkout",
+			"color":      "#eb364b",
+			"font_size":  "16",
+			"text_align": "left",
+		}},
+	})
+
+	expected := `{"definition":{"color":"#eb364b","font_size":"16","text":"Checkout","text_align":"left","type":"free_text"},"layout":{"height":2,"width":4,"x":0,"y":0}}`
+	if json := d.Get("json").(string); json != expected {
+		t.Errorf("expected json %s, got %s", expected, json)
+	}
+	if d.Id() != utils.ConvertToSha256(expected) {
+		t.Errorf("expected the ID to be the hash of the JSON, got %s", d.Id())
+	}
+}
+
+func TestDatadogDashboardWidgetDatasourceError(t *testing.T) {
+	dataSource := datadog.Provider().DataSourcesMap["datadog_dashboard_widget"]
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{})
+	if diags := dataSource.ReadContext(context.Background(), d, nil); !diags.HasError() {
+		t.Errorf("expected an error for a widget without definition")
+	}
+}
+
+func TestDatadogDashboardWidgetJSON(t *testing.T) {
+	ctx := context.Background()
+	providerConf := newFakeDashboardAPIProviderConfiguration(ctx, t)
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+
+	widgetJSON := readDashboardWidgetDatasource(t, map[string]interface{}{
+		"note_definition": []interface{}{map[string]interface{}{
+			"content":    "Shared note",
+			"text_align": "center",
+		}},
+	}).Get("json").(string)
+	config := map[string]interface{}{
+		"title":       "Widget JSON",
+		"layout_type": "ordered",
+		"widget": []interface{}{map[string]interface{}{
+			"free_text_definition": []interface{}{map[string]interface{}{"text": "Checkout"}},
+		}},
+		"widget_json": []interface{}{widgetJSON},
+	}
+
+	d := schema.TestResourceDataRaw(t, dashboardResource.Schema, config)
+	if diags := dashboardResource.CreateContext(ctx, d, providerConf); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if v := d.Get("widget.#"); v != 1 {
+		t.Errorf("expected 1 widget, got %v", v)
+	}
+	if v := d.Get("widget.0.free_text_definition.0.text"); v != "Checkout" {
+		t.Errorf("expected the free text widget first, got %v", v)
+	}
+	// The widget ID set by the API isn't part of the JSON
+	if v := d.Get("widget_json"); len(v.([]interface{})) != 1 || v.([]interface{})[0] != widgetJSON {
+		t.Errorf("expected widget_json [%s], got %v", widgetJSON, v)
+	}
+
+	if diags := dashboardResource.ReadContext(ctx, d, providerConf); len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	diff, err := dashboardResource.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config), providerConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		for k, attr := range diff.Attributes {
+			t.Errorf("unexpected diff on %s: %q => %q", k, attr.Old, attr.New)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogServiceLevelObjectiveHistoryDatasource(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := strings.ToLower(strings.ReplaceAll(uniqueEntityName(ctx, t), "-", "_"))
+	accProvider := testAccProvider(t, accProviders)
+
+	to := clockFromContext(ctx).Now()
+	from := to.Add(-30 * 24 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveHistorySLODestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceServiceLevelObjectiveHistoryConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.datadog_service_level_objective_history.foo", "slo_id", "datadog_service_level_objective.foo", "id"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_history.foo", "from_ts", strconv.FormatInt(from.Unix(), 10)),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_history.foo", "to_ts", strconv.FormatInt(to.Unix(), 10)),
+					// The target of the 30d threshold, rather than the target of the primary 7d time frame
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective_history.foo", "target", "99"),
+					resource.TestCheckResourceAttrSet("data.datadog_service_level_objective_history.foo", "sli_value"),
+					resource.TestCheckResourceAttrSet("data.datadog_service_level_objective_history.foo", "error_budget_remaining"),
+					resource.TestCheckResourceAttrSet("data.datadog_service_level_objective_history.foo", "burn_rate"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceServiceLevelObjectiveHistoryConfig(uniq string) string {
+	return fmt.Sprintf(`
+%s
+data "datadog_service_level_objective_history" "foo" {
+  slo_id = datadog_service_level_objective.foo.id
+  window = "30d"
+}`, testAccCheckDatadogServiceLevelObjectiveUniqueTagMetricConfig(uniq))
+}
+
+// testAccCheckDatadogServiceLevelObjectiveHistorySLODestroy checks the SLOs are destroyed, ignoring the history data
+// source whose ID isn't an SLO ID
+func testAccCheckDatadogServiceLevelObjectiveHistorySLODestroy(accProvider func() (*schema.Provider, error)) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		slos := terraform.NewState()
+		for name, r := range s.RootModule().Resources {
+			if r.Type == "datadog_service_level_objective" {
+				slos.RootModule().Resources[name] = r
+			}
+		}
+		return destroyServiceLevelObjectiveHelper(auth, slos, apiInstances)
+	}
+}
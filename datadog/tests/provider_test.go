@@ -55,6 +55,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/data_source_datadog_cloud_workload_security_agent_rules_test": "cloud-workload-security",
 	"tests/data_source_datadog_dashboard_test":                           "dashboard",
 	"tests/data_source_datadog_dashboard_list_test":                      "dashboard-lists",
+	"tests/data_source_datadog_dashboard_widget_test":                    "dashboards",
 	"tests/data_source_datadog_integration_aws_logs_services_test":       "integration-aws",
 	"tests/data_source_datadog_ip_ranges_test":                           "ip-ranges",
 	"tests/data_source_datadog_logs_archives_order_test":                 "logs-archive",
@@ -73,6 +74,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/data_source_datadog_security_monitoring_rules_test":           "security-monitoring",
 	"tests/data_source_datadog_security_monitoring_filters_test":         "security-monitoring",
 	"tests/data_source_datadog_service_level_objective_test":             "service-level-objectives",
+	"tests/data_source_datadog_service_level_objective_history_test":     "service-level-objectives",
 	"tests/data_source_datadog_service_level_objectives_test":            "service-level-objectives",
 	"tests/data_source_datadog_synthetics_locations_test":                "synthetics",
 	"tests/data_source_datadog_synthetics_global_variable_test":          "synthetics",
@@ -101,6 +103,7 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_dashboard_hostmap_test":                      "dashboards",
 	"tests/resource_datadog_dashboard_iframe_test":                       "dashboards",
 	"tests/resource_datadog_dashboard_image_test":                        "dashboards",
+	"tests/resource_datadog_dashboard_list_item_test":                    "dashboard-lists",
 	"tests/resource_datadog_dashboard_list_test":                         "dashboard-lists",
 	"tests/resource_datadog_dashboard_list_stream_test":                  "dashboards",
 	"tests/resource_datadog_dashboard_list_stream_storage_test":          "dashboards",
@@ -116,13 +119,18 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_dashboard_slo_list_test":                     "dashboards",
 	"tests/resource_datadog_dashboard_style_test":                        "dashboards",
 	"tests/resource_datadog_dashboard_sunburst_test":                     "dashboards",
+	"tests/resource_datadog_dashboard_tags_test":                         "dashboards",
 	"tests/resource_datadog_dashboard_test":                              "dashboards",
 	"tests/resource_datadog_dashboard_timeseries_test":                   "dashboards",
 	"tests/resource_datadog_dashboard_top_list_test":                     "dashboards",
 	"tests/resource_datadog_dashboard_trace_service_test":                "dashboards",
 	"tests/resource_datadog_dashboard_topology_map_test":                 "dashboards",
+	"tests/resource_datadog_dashboard_unparsed_test":                     "dashboards",
+	"tests/resource_datadog_dashboard_widget_round_trip_test":            "dashboards",
 	"tests/resource_datadog_dashboard_json_test":                         "dashboards-json",
+	"tests/resource_datadog_dashboard_layout_test":                       "dashboards",
 	"tests/resource_datadog_downtime_test":                               "downtimes",
+	"tests/resource_datadog_downtime_schedule_test":                      "downtimes",
 	"tests/resource_datadog_dashboard_geomap_test":                       "dashboards",
 	"tests/resource_datadog_integration_aws_lambda_arn_test":             "integration-aws",
 	"tests/resource_datadog_integration_aws_log_collection_test":         "integration-aws",
@@ -144,6 +152,8 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_monitor_test":                                "monitors",
 	"tests/resource_datadog_monitor_config_policy_test":                  "monitor-config-policies",
 	"tests/resource_datadog_monitor_json_test":                           "monitors-json",
+	"tests/resource_datadog_notebook_test":                               "notebooks",
+	"tests/resource_datadog_notebook_json_test":                          "notebooks",
 	"tests/resource_datadog_organization_settings_test":                  "organization",
 	"tests/resource_datadog_role_test":                                   "roles",
 	"tests/resource_datadog_screenboard_test":                            "dashboards",
@@ -152,7 +162,9 @@ var testFiles2EndpointTags = map[string]string{
 	"tests/resource_datadog_security_monitoring_filter_test":             "security-monitoring",
 	"tests/resource_datadog_service_account_test":                        "users",
 	"tests/resource_datadog_service_level_objective_test":                "service-level-objectives",
+	"tests/resource_datadog_service_level_objective_alert_test":          "service-level-objectives",
 	"tests/resource_datadog_service_definition_yaml_test":                "service-definition",
+	"tests/resource_datadog_shared_dashboard_test":                       "dashboards",
 	"tests/resource_datadog_slo_correction_test":                         "slo_correction",
 	"tests/resource_datadog_synthetics_test_test":                        "synthetics",
 	"tests/resource_datadog_synthetics_global_variable_test":             "synthetics",
@@ -609,6 +621,15 @@ func TestProvider_impl(t *testing.T) {
 	var _ = datadog.Provider()
 }
 
+func TestProvider_resourcePermissions(t *testing.T) {
+	p := datadog.Provider()
+	for resourceType := range utils.ResourcePermissions {
+		if _, ok := p.ResourcesMap[resourceType]; !ok {
+			t.Errorf("permissions are listed for unknown resource type %s", resourceType)
+		}
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	// Unset all regular env to avoid mistakenly running tests against wrong org
 	for _, v := range append(datadog.APPKeyEnvVars, datadog.APIKeyEnvVars...) {
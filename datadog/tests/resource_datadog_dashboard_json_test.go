@@ -2,10 +2,19 @@ package test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccDatadogDashboardJSONBasicTimeboard(t *testing.T) {
@@ -164,6 +173,96 @@ func TestDatadogDashListInDashboardJSON(t *testing.T) {
 	})
 }
 
+// fakeDashboardListsAPI adds the items of dashboard lists to the in-memory dashboard API
+type fakeDashboardListsAPI struct {
+	*fakeDashboardAPI
+	lists map[int64][]string
+}
+
+func (f *fakeDashboardListsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, "/api/v2/dashboard/lists/manual/") {
+		f.fakeDashboardAPI.ServeHTTP(w, r)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id, _ := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v2/dashboard/lists/manual/"), "/dashboards"), 10, 64)
+	dashboards, ok := f.lists[id]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var items struct {
+		Dashboards []map[string]interface{} `json:"dashboards"`
+	}
+	json.NewDecoder(r.Body).Decode(&items)
+	switch r.Method {
+	case http.MethodPost:
+		for _, item := range items.Dashboards {
+			f.lists[id] = append(f.lists[id], item["id"].(string))
+		}
+	case http.MethodDelete:
+		f.lists[id] = nil
+		for _, dashboard := range dashboards {
+			if dashboard != items.Dashboards[0]["id"] {
+				f.lists[id] = append(f.lists[id], dashboard)
+			}
+		}
+	}
+	response := map[string]interface{}{"dashboards": []interface{}{}}
+	for _, dashboard := range f.lists[id] {
+		response["dashboards"] = append(response["dashboards"].([]interface{}), map[string]interface{}{"id": dashboard, "type": "custom_timeboard"})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+func TestDatadogDashboardJSONListsReadBack(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeDashboardListsAPI{
+		fakeDashboardAPI: &fakeDashboardAPI{dashboards: map[string][]byte{}},
+		lists:            map[int64][]string{1: nil, 2: nil, 3: nil},
+	}
+	providerConf := newFakeAPIProviderConfiguration(ctx, t, api)
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard_json"]
+
+	config := map[string]interface{}{
+		"dashboard":       `{"title":"Dashboard lists","layout_type":"ordered","widgets":[]}`,
+		"dashboard_lists": []interface{}{1, 2, 3},
+	}
+	d := schema.TestResourceDataRaw(t, dashboardResource.Schema, config)
+	if diags := dashboardResource.CreateContext(ctx, d, providerConf); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	if lists := d.Get("dashboard_lists").(*schema.Set); lists.Len() != 3 {
+		t.Errorf("expected the dashboard in 3 lists, got %v", lists.List())
+	}
+
+	// Remove the dashboard from a list and delete another list outside of Terraform
+	api.lists[1] = nil
+	delete(api.lists, 2)
+	if diags := dashboardResource.ReadContext(ctx, d, providerConf); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	var lists []int
+	for _, id := range d.Get("dashboard_lists").(*schema.Set).List() {
+		lists = append(lists, id.(int))
+	}
+	sort.Ints(lists)
+	if fmt.Sprint(lists) != "[3]" {
+		t.Errorf("expected the dashboard in list 3 only, got %v", lists)
+	}
+
+	diff, err := dashboardResource.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config), providerConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["dashboard_lists.#"] == nil || diff.Attributes["dashboard_lists.#"].New != "3" {
+		t.Errorf("expected a diff adding the dashboard to the lists again, got %v", diff)
+	}
+}
+
 func TestAccDatadogDashboardJSONRbacDiff(t *testing.T) {
 	t.Parallel()
 	ctx, accProviders := testAccProviders(context.Background(), t)
@@ -0,0 +1,76 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The widget layouts are validated at plan time, the tests only diff the configuration against an empty state.
+
+func freeTextLayoutWidget(x, y, width, height int) map[string]interface{} {
+	return map[string]interface{}{
+		"widget_layout":        []interface{}{map[string]interface{}{"x": x, "y": y, "width": width, "height": height}},
+		"free_text_definition": []interface{}{map[string]interface{}{"text": "Widget"}},
+	}
+}
+
+func TestDashboardWidgetLayoutValidation(t *testing.T) {
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+
+	cases := map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"free overlap": {
+			config: map[string]interface{}{
+				"layout_type": "free",
+				"widget":      []interface{}{freeTextLayoutWidget(0, 0, 32, 16), freeTextLayoutWidget(16, 8, 32, 16)},
+			},
+			err: "widget.1: widget layout 32x16 at (16, 8) overlaps the layout 32x16 at (0, 0) of widget.0",
+		},
+		"free overlap allowed": {
+			config: map[string]interface{}{
+				"layout_type":               "free",
+				"allow_overlapping_widgets": true,
+				"widget":                    []interface{}{freeTextLayoutWidget(0, 0, 32, 16), freeTextLayoutWidget(16, 8, 32, 16)},
+			},
+		},
+		"free no overlap": {
+			config: map[string]interface{}{
+				"layout_type": "free",
+				"widget":      []interface{}{freeTextLayoutWidget(0, 0, 32, 16), freeTextLayoutWidget(32, 0, 32, 16)},
+			},
+		},
+		"ordered fixed overlap": {
+			config: map[string]interface{}{
+				"layout_type": "ordered",
+				"reflow_type": "fixed",
+				"widget":      []interface{}{freeTextLayoutWidget(0, 0, 4, 2), freeTextLayoutWidget(2, 1, 4, 2)},
+			},
+			err: "widget.1: widget layout 4x2 at (2, 1) overlaps the layout 4x2 at (0, 0) of widget.0",
+		},
+		"ordered overlap allowed": {
+			config: map[string]interface{}{
+				"layout_type":               "ordered",
+				"reflow_type":               "fixed",
+				"allow_overlapping_widgets": true,
+				"widget":                    []interface{}{freeTextLayoutWidget(0, 0, 4, 2)},
+			},
+			err: "`allow_overlapping_widgets` is only supported on free dashboards",
+		},
+	}
+	for name, tc := range cases {
+		tc.config["title"] = "Widget layouts"
+		_, err := dashboardResource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(tc.config), &datadog.ProviderConfiguration{})
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		} else if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected error %q, got %v", name, tc.err, err)
+		}
+	}
+}
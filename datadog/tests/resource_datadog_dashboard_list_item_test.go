@@ -0,0 +1,174 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+
+	"github.com/DataDog/datadog-api-client-go/v2/api/datadogV2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogDashboardListItem_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDatadogDashboardListItemDestroy(accProvider),
+			testAccCheckDatadogDashListDestroy(accProvider),
+			checkDashboardDestroy(accProvider),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDashboardListItemConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDashboardListItemExists(accProvider),
+					resource.TestCheckResourceAttrPair("datadog_dashboard_list_item.foo", "list_id", "datadog_dashboard_list.foo", "id"),
+					resource.TestCheckResourceAttrPair("datadog_dashboard_list_item.foo", "dashboard_id", "datadog_dashboard.foo", "id"),
+					resource.TestCheckResourceAttr("datadog_dashboard_list_item.foo", "type", "custom_timeboard"),
+				),
+			},
+			{
+				ResourceName: "datadog_dashboard_list_item.foo",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					r := s.RootModule().Resources["datadog_dashboard_list_item.foo"]
+					return fmt.Sprintf("%s:%s", r.Primary.Attributes["list_id"], r.Primary.Attributes["dashboard_id"]), nil
+				},
+				ImportStateVerify: true,
+			},
+			{
+				// Removing the dashboard from the list outside of Terraform plans to add it again
+				Config:             testAccCheckDatadogDashboardListItemConfig(uniq),
+				Check:              testAccRemoveDatadogDashboardListItem(accProvider),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDatadogDashboardListItemConfig(uniq),
+				Check:  testAccCheckDatadogDashboardListItemExists(accProvider),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDashboardListItemConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard_list" "foo" {
+  name = "%s"
+
+  # The dashboards of the list are managed by datadog_dashboard_list_item
+  lifecycle {
+    ignore_changes = [dash_item]
+  }
+}
+
+resource "datadog_dashboard" "foo" {
+  title       = "%s"
+  layout_type = "ordered"
+
+  widget {
+    note_definition {
+      content = "Listed by its own resource"
+    }
+  }
+}
+
+resource "datadog_dashboard_list_item" "foo" {
+  list_id      = datadog_dashboard_list.foo.id
+  dashboard_id = datadog_dashboard.foo.id
+}`, uniq, uniq)
+}
+
+// getDatadogDashboardListItem returns the item of the dashboard list matching the resource, or nil when the
+// dashboard or the list don't exist anymore
+func getDatadogDashboardListItem(accProvider func() (*schema.Provider, error), r *terraform.ResourceState) (*datadogV2.DashboardListItem, error) {
+	provider, _ := accProvider()
+	providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	listID, dashboardID, err := utils.DashboardListAndDashboardFromID(r.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	items, httpResp, err := apiInstances.GetDashboardListsApiV2().GetDashboardListItems(auth, listID)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("received an error retrieving dashboard list %d: %s", listID, err)
+	}
+	for _, item := range items.GetDashboards() {
+		if item.GetId() == dashboardID {
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func testAccCheckDatadogDashboardListItemExists(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_dashboard_list_item" {
+				continue
+			}
+			item, err := getDatadogDashboardListItem(accProvider, r)
+			if err != nil {
+				return err
+			}
+			if item == nil {
+				return fmt.Errorf("dashboard list item %s doesn't exist", r.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDashboardListItemDestroy(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_dashboard_list_item" {
+				continue
+			}
+			item, err := getDatadogDashboardListItem(accProvider, r)
+			if err != nil {
+				return err
+			}
+			if item != nil {
+				return fmt.Errorf("dashboard list item %s still exists", r.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccRemoveDatadogDashboardListItem(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		r := s.RootModule().Resources["datadog_dashboard_list_item.foo"]
+		listID, dashboardID, err := utils.DashboardListAndDashboardFromID(r.Primary.ID)
+		if err != nil {
+			return err
+		}
+		items := datadogV2.NewDashboardListDeleteItemsRequest()
+		items.SetDashboards([]datadogV2.DashboardListItemRequest{*datadogV2.NewDashboardListItemRequest(dashboardID, datadogV2.DashboardType(r.Primary.Attributes["type"]))})
+		if _, _, err := apiInstances.GetDashboardListsApiV2().DeleteDashboardListItems(auth, listID, *items); err != nil {
+			return fmt.Errorf("received an error removing dashboard list item %s: %s", r.Primary.ID, err)
+		}
+		return nil
+	}
+}
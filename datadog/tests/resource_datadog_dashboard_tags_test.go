@@ -0,0 +1,86 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+)
+
+func TestDashboardDefaultTags(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeDashboardAPI{dashboards: map[string][]byte{}}
+	providerConf := newFakeAPIProviderConfiguration(ctx, t, api)
+	providerConf.DefaultTags = []string{"env:prod"}
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+
+	sentTags := func(id string) []string {
+		var dashboard struct {
+			Tags []string `json:"tags"`
+		}
+		if err := json.Unmarshal(api.dashboards[id], &dashboard); err != nil {
+			t.Fatal(err)
+		}
+		sort.Strings(dashboard.Tags)
+		return dashboard.Tags
+	}
+	stateTags := func(state *terraform.InstanceState, attribute string) []string {
+		d := dashboardResource.Data(state)
+		tags := make([]string, 0)
+		for _, tag := range d.Get(attribute).(*schema.Set).List() {
+			tags = append(tags, tag.(string))
+		}
+		sort.Strings(tags)
+		return tags
+	}
+
+	d := schema.TestResourceDataRaw(t, dashboardResource.Schema, map[string]interface{}{
+		"title":       "Default tags",
+		"layout_type": "ordered",
+		"tags":        []interface{}{"team:frontend"},
+	})
+	if diags := dashboardResource.CreateContext(ctx, d, providerConf); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	state := d.State()
+	if tags := sentTags(d.Id()); !reflect.DeepEqual(tags, []string{"env:prod", "team:frontend"}) {
+		t.Errorf("expected the default tags to be sent, got %v", tags)
+	}
+	if tags := stateTags(state, "tags"); !reflect.DeepEqual(tags, []string{"team:frontend"}) {
+		t.Errorf("expected the default tags not to be set in `tags`, got %v", tags)
+	}
+	if tags := stateTags(state, "tags_all"); !reflect.DeepEqual(tags, []string{"env:prod", "team:frontend"}) {
+		t.Errorf("expected the default tags to be set in `tags_all`, got %v", tags)
+	}
+
+	// Removing every tag clears them on the dashboard
+	providerConf.DefaultTags = nil
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"title":       "Default tags",
+		"layout_type": "ordered",
+	})
+	diff, err := dashboardResource.Diff(ctx, state, config, providerConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state, diags := dashboardResource.Apply(ctx, state, diff, providerConf)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal(api.dashboards[state.ID], &dashboard); err != nil {
+		t.Fatal(err)
+	}
+	if tags, ok := dashboard["tags"].([]interface{}); !ok || len(tags) != 0 {
+		t.Errorf("expected an empty list of tags to be sent, got %v", dashboard["tags"])
+	}
+	if tags := stateTags(state, "tags_all"); len(tags) != 0 {
+		t.Errorf("expected `tags_all` to be empty, got %v", tags)
+	}
+}
@@ -0,0 +1,113 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+)
+
+func TestDashboardUnparsedWidgets(t *testing.T) {
+	ctx := context.Background()
+	providerConf := newFakeDashboardAPIProviderConfiguration(ctx, t)
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+
+	cases := map[string]struct {
+		definition string
+		typed      string
+	}{
+		// Unknown widget types are kept as raw JSON
+		"unknown widget type": {`{"type":"future_widget","title":"Future"}`, ""},
+		// Known widget types with an unknown nested value keep their typed definition, and the raw nested value
+		"unknown nested value": {`{"type":"timeseries","title":"Known","requests":[{"q":"avg:system.cpu.user{*}","display_type":"future_display"}]}`, "timeseries_definition"},
+	}
+	for name, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dashboardResource.Schema, map[string]interface{}{
+			"title":       "Unparsed widgets",
+			"layout_type": "ordered",
+			"widget":      []interface{}{map[string]interface{}{"unparsed_definition_json": tc.definition}},
+		})
+
+		diags := dashboardResource.CreateContext(ctx, d, providerConf)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics %v", name, diags)
+		}
+		if len(diags) == 0 || diags[0].Severity != diag.Warning {
+			t.Errorf("%s: expected a warning about the unparsed elements, got %v", name, diags)
+		}
+
+		unparsed := d.Get("widget.0.unparsed_definition_json").(string)
+		if tc.typed == "" {
+			if unparsed == "" {
+				t.Errorf("%s: expected the widget to be kept as raw JSON", name)
+			}
+			continue
+		}
+		if unparsed != "" {
+			t.Errorf("%s: expected the widget not to be kept as raw JSON, got %s", name, unparsed)
+		}
+		if title := d.Get("widget.0." + tc.typed + ".0.title"); title != "Known" {
+			t.Errorf("%s: expected the typed definition to be kept, got title %v", name, title)
+		}
+		if elements := d.Get("widget.0.unparsed_elements_json").(string); !strings.Contains(elements, "future_display") {
+			t.Errorf("%s: expected the unparsed elements to be kept as raw JSON, got %s", name, elements)
+		}
+	}
+}
+
+func TestDashboardUnparsedWidgetElementsUpdate(t *testing.T) {
+	ctx := context.Background()
+	api := &fakeDashboardAPI{dashboards: map[string][]byte{}}
+	providerConf := newFakeAPIProviderConfiguration(ctx, t, api)
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+
+	d := schema.TestResourceDataRaw(t, dashboardResource.Schema, map[string]interface{}{
+		"title":       "Unparsed widget elements",
+		"layout_type": "ordered",
+		"widget": []interface{}{map[string]interface{}{
+			"unparsed_definition_json": `{"type":"timeseries","title":"Known","requests":[{"q":"avg:system.cpu.user{*}","display_type":"future_display"}]}`,
+		}},
+	})
+	if diags := dashboardResource.CreateContext(ctx, d, providerConf); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	// Update the dashboard with the typed definition of the widget, as read back by the provider
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"title":       "Unparsed widget elements updated",
+		"layout_type": "ordered",
+		"widget": []interface{}{map[string]interface{}{
+			"timeseries_definition": []interface{}{map[string]interface{}{
+				"title":   "Known",
+				"request": []interface{}{map[string]interface{}{"q": "avg:system.cpu.system{*}"}},
+			}},
+		}},
+	})
+	diff, err := dashboardResource.Diff(ctx, d.State(), config, providerConf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, diags := dashboardResource.Apply(ctx, d.State(), diff, providerConf); diags.HasError() {
+		t.Fatalf("unexpected diagnostics %v", diags)
+	}
+
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal(api.dashboards[d.Id()], &dashboard); err != nil {
+		t.Fatal(err)
+	}
+	for pointer, expected := range map[string]interface{}{
+		"/widgets/0/definition/requests/0/q":            "avg:system.cpu.system{*}",
+		"/widgets/0/definition/requests/0/display_type": "future_display",
+	} {
+		if value, _ := utils.GetJSONPointer(dashboard, pointer); value != expected {
+			t.Errorf("%s: expected the unparsed value to be merged with the configured request, got %v", pointer, value)
+		}
+	}
+}
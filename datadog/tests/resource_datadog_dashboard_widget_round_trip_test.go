@@ -0,0 +1,470 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/validators"
+
+	common "github.com/DataDog/datadog-api-client-go/v2/api/datadog"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The widget round trip tests are offline: they create dashboards against an in-memory dashboard API, so that every
+// widget definition goes through the `buildDatadog*` helpers, the JSON serialization of the API client, and the
+// `buildTerraform*` helpers. Any attribute which doesn't come back as it was configured would show up as a diff.
+
+// widgetRoundTripIterations is the number of configurations generated for each widget definition
+const widgetRoundTripIterations = 20
+
+// fakeDashboardAPI stores the dashboards created or updated through it, and returns them with the IDs set by the API
+type fakeDashboardAPI struct {
+	mu         sync.Mutex
+	dashboards map[string][]byte
+	widgetID   int64
+}
+
+func (f *fakeDashboardAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case (r.Method == http.MethodPost && r.URL.Path == "/api/v1/dashboard") || (r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/api/v1/dashboard/")):
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var dashboard map[string]interface{}
+		if err := json.Unmarshal(body, &dashboard); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id := fmt.Sprintf("rt-%d", len(f.dashboards))
+		if r.Method == http.MethodPut {
+			id = strings.TrimPrefix(r.URL.Path, "/api/v1/dashboard/")
+			if _, ok := f.dashboards[id]; !ok {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		dashboard["id"] = id
+		if widgets, ok := dashboard["widgets"].([]interface{}); ok {
+			f.setWidgetIDs(widgets)
+		}
+		body, _ = json.Marshal(dashboard)
+		f.dashboards[id] = body
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/dashboard/"):
+		body, ok := f.dashboards[strings.TrimPrefix(r.URL.Path, "/api/v1/dashboard/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// setWidgetIDs sets the IDs of the widgets, the way the dashboard API does
+func (f *fakeDashboardAPI) setWidgetIDs(widgets []interface{}) {
+	for _, w := range widgets {
+		if widget, ok := w.(map[string]interface{}); ok {
+			f.widgetID++
+			widget["id"] = f.widgetID
+		}
+	}
+}
+
+func newFakeDashboardAPIProviderConfiguration(ctx context.Context, t *testing.T) *datadog.ProviderConfiguration {
+	return newFakeAPIProviderConfiguration(ctx, t, &fakeDashboardAPI{dashboards: map[string][]byte{}})
+}
+
+// newFakeAPIProviderConfiguration returns a provider configuration sending its requests to an in-memory API
+func newFakeAPIProviderConfiguration(ctx context.Context, t *testing.T, api http.Handler) *datadog.ProviderConfiguration {
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	auth, err := buildContext(ctx, "fake-api-key", "fake-app-key", server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The user agent of buildDatadogClient requires a configured provider
+	config := common.NewConfiguration()
+	config.HTTPClient = server.Client()
+	return &datadog.ProviderConfiguration{
+		DatadogApiInstances: &utils.ApiInstances{HttpClient: common.NewAPIClient(config)},
+		Auth:                auth,
+		Now:                 time.Now,
+	}
+}
+
+// widgetExclusiveAttributes are groups of sibling attributes of which the build helpers only send one, e.g. the
+// queries of requests. Only one attribute of each group is generated in a block.
+var widgetExclusiveAttributes = [][]string{
+	{"q", "apm_query", "apm_stats_query", "audit_query", "log_query", "network_query", "process_query", "rum_query", "security_query", "query"},
+	{"apm_dependency_stats_query", "apm_resource_stats_query", "event_query", "metric_query", "process_query"},
+	{"label", "override_label"},
+	{"legend_inline", "legend_table"},
+}
+
+// widgetDependentAttributes are attributes which are only sent along with a sibling attribute, e.g. the formulas of
+// the `query` of requests
+var widgetDependentAttributes = map[string]string{
+	"formula":   "query",
+	"is_hidden": "override_label",
+}
+
+// widgetConditionalAttributes are attributes which are only sent for a given value of a sibling attribute
+var widgetConditionalAttributes = map[string]struct{ attribute, value string }{
+	"event_size": {"data_source", "event_stream"},
+}
+
+// widgetConfigGenerator generates widget configurations setting every attribute of the schema, with random values
+// accepted by its validation
+type widgetConfigGenerator struct {
+	rand *rand.Rand
+	// generated holds the paths of the generated attributes without list indexes, e.g. `timeseries_definition.request.q`
+	generated map[string]bool
+}
+
+func (g *widgetConfigGenerator) block(s map[string]*schema.Schema, path string) map[string]interface{} {
+	// Widgets, e.g. the ones of groups, only have one of their definitions
+	var definitions []string
+	for _, k := range sortedSchemaKeys(s) {
+		if strings.HasSuffix(k, "_definition") {
+			definitions = append(definitions, k)
+		}
+	}
+	definition := ""
+	if len(definitions) > 0 {
+		definition = definitions[g.rand.Intn(len(definitions))]
+	}
+
+	skipped := map[string]bool{}
+	for _, group := range widgetExclusiveAttributes {
+		var present []string
+		for _, k := range group {
+			if _, ok := s[k]; ok {
+				present = append(present, k)
+			}
+		}
+		if len(present) < 2 {
+			continue
+		}
+		kept := present[g.rand.Intn(len(present))]
+		for _, k := range present {
+			skipped[k] = k != kept
+		}
+	}
+	for k, dependency := range widgetDependentAttributes {
+		if _, ok := s[dependency]; ok && skipped[dependency] {
+			skipped[k] = true
+		}
+	}
+
+	config := map[string]interface{}{}
+	var conditional []string
+	for _, k := range sortedSchemaKeys(s) {
+		attr := s[k]
+		if (attr.Computed && !attr.Optional) || attr.Deprecated != "" || skipped[k] {
+			continue
+		}
+		if strings.HasSuffix(k, "_definition") && k != definition {
+			continue
+		}
+		// The raw JSON definition is an alternative to the typed ones
+		if k == "unparsed_definition_json" {
+			continue
+		}
+		if _, ok := widgetConditionalAttributes[k]; ok {
+			conditional = append(conditional, k)
+			continue
+		}
+		config[k] = g.value(attr, joinWidgetPath(path, k))
+	}
+	// Conditional attributes are generated once the attribute they depend on is
+	for _, k := range conditional {
+		condition := widgetConditionalAttributes[k]
+		if _, ok := s[condition.attribute]; !ok || config[condition.attribute] == condition.value {
+			config[k] = g.value(s[k], joinWidgetPath(path, k))
+		}
+	}
+	return config
+}
+
+func (g *widgetConfigGenerator) value(s *schema.Schema, path string) interface{} {
+	g.generated[path] = true
+
+	switch s.Type {
+	case schema.TypeBool:
+		// Only non-zero values can tell whether an attribute is read
+		return true
+	case schema.TypeInt:
+		if values := enumValues(s); len(values) > 0 {
+			var v int
+			fmt.Sscan(values[g.rand.Intn(len(values))], &v)
+			return v
+		}
+		return g.valid(s, func() interface{} { return 1 + g.rand.Intn(10) })
+	case schema.TypeFloat:
+		return g.valid(s, func() interface{} { return float64(1+g.rand.Intn(100)) / 4 })
+	case schema.TypeString:
+		if values := enumValues(s); len(values) > 0 {
+			return values[g.rand.Intn(len(values))]
+		}
+		name := path[strings.LastIndex(path, ".")+1:]
+		candidates := []string{fmt.Sprintf("%s-%d", name, g.rand.Intn(1000)), fmt.Sprint(1 + g.rand.Intn(10)), "auto"}
+		i := 0
+		return g.valid(s, func() interface{} {
+			v := candidates[i%len(candidates)]
+			i++
+			return v
+		})
+	case schema.TypeList, schema.TypeSet:
+		count := 1 + g.rand.Intn(2)
+		if s.MaxItems > 0 && count > s.MaxItems {
+			count = s.MaxItems
+		}
+		values := make([]interface{}, count)
+		for i := range values {
+			switch elem := s.Elem.(type) {
+			case *schema.Resource:
+				values[i] = g.block(elem.Schema, path)
+			case *schema.Schema:
+				values[i] = g.value(elem, path)
+			}
+		}
+		return values
+	case schema.TypeMap:
+		if elem, ok := s.Elem.(*schema.Schema); ok {
+			return map[string]interface{}{"key": g.value(elem, path)}
+		}
+		return map[string]interface{}{"key": "value"}
+	}
+	panic(fmt.Sprintf("%s: unsupported attribute type %s", path, s.Type))
+}
+
+// valid returns the first generated value accepted by the validation of the attribute
+func (g *widgetConfigGenerator) valid(s *schema.Schema, generate func() interface{}) interface{} {
+	for i := 0; i < 10; i++ {
+		v := generate()
+		if s.ValidateFunc != nil {
+			if _, errs := s.ValidateFunc(v, ""); len(errs) > 0 {
+				continue
+			}
+		}
+		if s.ValidateDiagFunc != nil && s.ValidateDiagFunc(v, cty.Path{}).HasError() {
+			continue
+		}
+		return v
+	}
+	panic("failed to generate a valid value")
+}
+
+var enumValueRegexp = regexp.MustCompile("`([^`]*)`")
+
+// enumValues returns the allowed values of enum attributes, the same way they're documented
+func enumValues(s *schema.Schema) []string {
+	if s.ValidateDiagFunc == nil {
+		return nil
+	}
+	diags := s.ValidateDiagFunc(validators.EnumChecker{}, cty.Path{})
+	if len(diags) == 0 || diags[0].Summary != "Allowed values" {
+		return nil
+	}
+	var values []string
+	for _, match := range enumValueRegexp.FindAllStringSubmatch(diags[0].Detail, -1) {
+		values = append(values, match[1])
+	}
+	return values
+}
+
+func sortedSchemaKeys(s map[string]*schema.Schema) []string {
+	keys := make([]string, 0, len(s))
+	for k := range s {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinWidgetPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+	return path + "." + k
+}
+
+// diffWidgetValues returns the differences between the configured and the read values of a widget
+func diffWidgetValues(path string, expected, actual interface{}) []string {
+	switch e := expected.(type) {
+	case map[string]interface{}:
+		a, _ := actual.(map[string]interface{})
+		var diffs []string
+		for _, k := range sortedMapKeys(e) {
+			diffs = append(diffs, diffWidgetValues(joinWidgetPath(path, k), e[k], a[k])...)
+		}
+		return diffs
+	case []interface{}:
+		a, _ := actual.([]interface{})
+		if len(e) != len(a) {
+			return []string{fmt.Sprintf("%s: expected %d elements, got %d", path, len(e), len(a))}
+		}
+		var diffs []string
+		for i := range e {
+			diffs = append(diffs, diffWidgetValues(fmt.Sprintf("%s.%d", path, i), e[i], a[i])...)
+		}
+		return diffs
+	case *schema.Set:
+		if a, ok := actual.(*schema.Set); !ok || !e.Equal(a) {
+			return []string{fmt.Sprintf("%s: expected %v, got %v", path, e.List(), actual)}
+		}
+		return nil
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		return []string{fmt.Sprintf("%s: expected %#v, got %#v", path, expected, actual)}
+	}
+	return nil
+}
+
+// markPopulatedWidgetPaths adds the paths of the non-zero read values of a widget to populated
+func markPopulatedWidgetPaths(path string, v interface{}, populated map[string]bool) {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		for k, child := range value {
+			markPopulatedWidgetPaths(joinWidgetPath(path, k), child, populated)
+		}
+	case []interface{}:
+		for _, child := range value {
+			markPopulatedWidgetPaths(path, child, populated)
+		}
+	case *schema.Set:
+		markPopulatedWidgetPaths(path, value.List(), populated)
+	default:
+		if v != nil && !reflect.ValueOf(v).IsZero() {
+			populated[path] = true
+		}
+	}
+}
+
+func sortedMapKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func TestDashboardWidgetRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	providerConf := newFakeDashboardAPIProviderConfiguration(ctx, t)
+
+	dashboardResource := datadog.Provider().ResourcesMap["datadog_dashboard"]
+	widgetSchema := dashboardResource.Schema["widget"].Elem.(*schema.Resource).Schema
+
+	var definitions []string
+	for _, k := range sortedSchemaKeys(widgetSchema) {
+		if strings.HasSuffix(k, "_definition") {
+			definitions = append(definitions, k)
+		}
+	}
+	if len(definitions) != 31 {
+		t.Errorf("expected 31 widget definitions, got %d: %v", len(definitions), definitions)
+	}
+
+	for _, definition := range definitions {
+		definition := definition
+		t.Run(definition, func(t *testing.T) {
+			definitionSchema := widgetSchema[definition].Elem.(*schema.Resource).Schema
+			generator := &widgetConfigGenerator{generated: map[string]bool{}}
+			populated := map[string]bool{}
+			diffs := map[string]string{}
+
+			for seed := int64(1); seed <= widgetRoundTripIterations; seed++ {
+				generator.rand = rand.New(rand.NewSource(seed))
+				widget := map[string]interface{}{
+					definition: []interface{}{generator.block(definitionSchema, definition)},
+				}
+				d := schema.TestResourceDataRaw(t, dashboardResource.Schema, map[string]interface{}{
+					"title":       "Widget round trip",
+					"layout_type": "ordered",
+					"widget":      []interface{}{widget},
+				})
+				expected := d.Get("widget.0." + definition)
+
+				if diags := dashboardResource.CreateContext(ctx, d, providerConf); len(diags) > 0 {
+					t.Fatalf("seed %d: unexpected diagnostics %v", seed, diags)
+				}
+				actual := d.Get("widget.0." + definition)
+
+				for _, diff := range diffWidgetValues(definition, expected, actual) {
+					// Report each attribute once, with the first seed it differs with
+					key := regexp.MustCompile(`\.\d+`).ReplaceAllString(diff[:strings.Index(diff, ":")], "")
+					if _, ok := diffs[key]; !ok {
+						diffs[key] = fmt.Sprintf("seed %d: %s", seed, diff)
+					}
+				}
+				markPopulatedWidgetPaths(definition, actual, populated)
+			}
+
+			for _, k := range sortedDiffKeys(diffs) {
+				t.Errorf("%s", diffs[k])
+			}
+			var generated []string
+			for path := range generator.generated {
+				generated = append(generated, path)
+			}
+			sort.Strings(generated)
+			for _, path := range generated {
+				if !populated[path] && !isWidgetBlockPath(definitionSchema, definition, path) {
+					t.Errorf("%s is never populated on read", path)
+				}
+			}
+		})
+	}
+}
+
+func sortedDiffKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// isWidgetBlockPath returns whether path is a nested block, whose own attributes are checked instead
+func isWidgetBlockPath(s map[string]*schema.Schema, prefix, path string) bool {
+	for _, k := range strings.Split(strings.TrimPrefix(path, prefix+"."), ".") {
+		attr, ok := s[k]
+		if !ok {
+			return false
+		}
+		elem, ok := attr.Elem.(*schema.Resource)
+		if !ok {
+			return false
+		}
+		s = elem.Schema
+	}
+	return true
+}
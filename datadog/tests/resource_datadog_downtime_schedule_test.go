@@ -0,0 +1,369 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogDowntimeSchedule_Recurring(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy:      testAccCheckDatadogDowntimeScheduleDestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeScheduleRecurringConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeScheduleExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "scope", "env:staging"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "monitor_identifier.0.monitor_tags.#", "1"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.recurrence.0.duration", "2h"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.recurrence.0.rrule", "FREQ=DAILY;INTERVAL=1"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.recurrence.0.start", "2050-03-01T09:00:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.timezone", "Europe/Paris"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "one_time_schedule.#", "0"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "message", uniq),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "notify_end_types.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeScheduleRecurringConfigUpdated(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeScheduleExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.recurrence.0.duration", "3h"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.0.recurrence.0.start", "2050-03-01T09:00:00"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "mute_first_recovery_notification", "true"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "notify_end_types.#", "1"),
+					resource.TestCheckTypeSetElemAttr("datadog_downtime_schedule.foo", "notify_end_types.*", "expired"),
+				),
+			},
+			{
+				ResourceName:      "datadog_downtime_schedule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDatadogDowntimeSchedule_OneTime(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	start := clockFromContext(ctx).Now().UTC().Add(time.Hour).Truncate(time.Second)
+	end := start.Add(time.Hour)
+	endUpdated := end.Add(time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy:      testAccCheckDatadogDowntimeScheduleDestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogDowntimeScheduleOneTimeConfig(uniq, start, end),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeScheduleExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "scope", "env:staging"),
+					resource.TestCheckResourceAttrWith("datadog_downtime_schedule.foo", "one_time_schedule.0.start", checkDowntimeScheduleDatetime(start)),
+					resource.TestCheckResourceAttrWith("datadog_downtime_schedule.foo", "one_time_schedule.0.end", checkDowntimeScheduleDatetime(end)),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "recurring_schedule.#", "0"),
+					resource.TestCheckResourceAttr("datadog_downtime_schedule.foo", "display_timezone", "UTC"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogDowntimeScheduleOneTimeConfig(uniq, start, endUpdated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogDowntimeScheduleExists(accProvider),
+					resource.TestCheckResourceAttrWith("datadog_downtime_schedule.foo", "one_time_schedule.0.start", checkDowntimeScheduleDatetime(start)),
+					resource.TestCheckResourceAttrWith("datadog_downtime_schedule.foo", "one_time_schedule.0.end", checkDowntimeScheduleDatetime(endUpdated)),
+				),
+			},
+			{
+				ResourceName:      "datadog_downtime_schedule.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogDowntimeScheduleRecurringConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime_schedule" "foo" {
+  scope = "env:staging"
+  monitor_identifier {
+    monitor_tags = ["*"]
+  }
+  recurring_schedule {
+    recurrence {
+      duration = "2h"
+      rrule    = "FREQ=DAILY;INTERVAL=1"
+      start    = "2050-03-01T09:00"
+    }
+    timezone = "Europe/Paris"
+  }
+  message = "%s"
+}`, uniq)
+}
+
+func testAccCheckDatadogDowntimeScheduleRecurringConfigUpdated(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime_schedule" "foo" {
+  scope = "env:staging"
+  monitor_identifier {
+    monitor_tags = ["*"]
+  }
+  recurring_schedule {
+    recurrence {
+      duration = "3h"
+      rrule    = "FREQ=DAILY;INTERVAL=1"
+      start    = "2050-03-01T09:00:00"
+    }
+    timezone = "Europe/Paris"
+  }
+  message                          = "%s"
+  mute_first_recovery_notification = true
+  notify_end_types                 = ["expired"]
+}`, uniq)
+}
+
+func testAccCheckDatadogDowntimeScheduleOneTimeConfig(uniq string, start, end time.Time) string {
+	return fmt.Sprintf(`
+resource "datadog_downtime_schedule" "foo" {
+  scope = "env:staging"
+  monitor_identifier {
+    monitor_tags = ["service:%s"]
+  }
+  one_time_schedule {
+    start = "%s"
+    end   = "%s"
+  }
+  message = "%s"
+}`, uniq, start.Format(time.RFC3339), end.Format(time.RFC3339), uniq)
+}
+
+// checkDowntimeScheduleDatetime checks a date of the state, which the API may format differently than the config
+func checkDowntimeScheduleDatetime(expected time.Time) resource.CheckResourceAttrWithFunc {
+	return func(value string) error {
+		actual, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return err
+		}
+		if !actual.Equal(expected) {
+			return fmt.Errorf("expected %s, got %s", expected.Format(time.RFC3339), value)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDowntimeScheduleExists(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		httpClient := providerConf.DatadogApiInstances.HttpClient
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_downtime_schedule" {
+				continue
+			}
+			if _, _, err := utils.SendRequest(auth, httpClient, "GET", "/api/v2/downtime/"+r.Primary.ID, nil); err != nil {
+				return fmt.Errorf("received an error retrieving downtime schedule %s", err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogDowntimeScheduleDestroy(accProvider func() (*schema.Provider, error)) func(*terraform.State) error {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		httpClient := providerConf.DatadogApiInstances.HttpClient
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_downtime_schedule" {
+				continue
+			}
+			respByte, httpResp, err := utils.SendRequest(auth, httpClient, "GET", "/api/v2/downtime/"+r.Primary.ID, nil)
+			if err != nil {
+				if httpResp != nil && httpResp.StatusCode == 404 {
+					continue
+				}
+				return fmt.Errorf("received an error retrieving downtime schedule %s", err)
+			}
+			// Deleted downtimes are canceled, and still returned by the API
+			var resp struct {
+				Data struct {
+					Attributes struct {
+						Status string `json:"status"`
+					} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(respByte, &resp); err != nil {
+				return err
+			}
+			if resp.Data.Attributes.Status != "canceled" {
+				return fmt.Errorf("downtime schedule %s is still %s", r.Primary.ID, resp.Data.Attributes.Status)
+			}
+		}
+		return nil
+	}
+}
+
+func TestDowntimeScheduleDatetimeDiffSuppress(t *testing.T) {
+	downtimeScheduleSchema := datadog.Provider().ResourcesMap["datadog_downtime_schedule"].Schema
+	recurringSchedule := downtimeScheduleSchema["recurring_schedule"].Elem.(*schema.Resource).Schema
+	recurrence := recurringSchedule["recurrence"].Elem.(*schema.Resource).Schema
+	oneTimeSchedule := downtimeScheduleSchema["one_time_schedule"].Elem.(*schema.Resource).Schema
+
+	cases := []struct {
+		attribute  *schema.Schema
+		old        string
+		new        string
+		suppressed bool
+	}{
+		{recurrence["start"], "2023-03-01T09:00:00", "2023-03-01T09:00", true},
+		{recurrence["start"], "2023-03-01T09:00", "2023-03-01T09:00:00", true},
+		{recurrence["start"], "2023-03-01T09:00:00", "2023-03-01T09:00:00", true},
+		{recurrence["start"], "2023-03-01T09:00:00", "2023-03-01T09:30", false},
+		{recurrence["start"], "", "2023-03-01T09:00", false},
+		{oneTimeSchedule["start"], "2023-03-01T09:00:00.000Z", "2023-03-01T09:00:00Z", true},
+		{oneTimeSchedule["start"], "2023-03-01T09:00:00Z", "2023-03-01T10:00:00+01:00", true},
+		{oneTimeSchedule["end"], "2023-03-01T09:00:00.000Z", "2023-03-02T09:00:00Z", false},
+		{oneTimeSchedule["end"], "2023-03-01T09:00:00Z", "", false},
+		{oneTimeSchedule["end"], "not a date", "not a date", false},
+	}
+	for _, tc := range cases {
+		if suppressed := tc.attribute.DiffSuppressFunc("", tc.old, tc.new, nil); suppressed != tc.suppressed {
+			t.Errorf("%q -> %q: expected suppressed to be %t, got %t", tc.old, tc.new, tc.suppressed, suppressed)
+		}
+	}
+}
+
+// fakeDowntimeScheduleAPI records the attributes sent to the v2 downtime API, and returns them as is
+type fakeDowntimeScheduleAPI struct {
+	mu         sync.Mutex
+	attributes []map[string]interface{}
+}
+
+func (f *fakeDowntimeScheduleAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var body struct {
+		Data struct {
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"data"`
+	}
+	payload, _ := ioutil.ReadAll(r.Body)
+	if err := json.Unmarshal(payload, &body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	f.attributes = append(f.attributes, body.Data.Attributes)
+
+	resp, _ := json.Marshal(map[string]interface{}{
+		"data": map[string]interface{}{
+			"id":         "00000000-0000-1234-0000-000000000000",
+			"type":       "downtime",
+			"attributes": body.Data.Attributes,
+		},
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(resp)
+}
+
+func TestDowntimeScheduleAttributes(t *testing.T) {
+	ctx := context.Background()
+	downtimeScheduleResource := datadog.Provider().ResourcesMap["datadog_downtime_schedule"]
+
+	cases := map[string]struct {
+		config  map[string]interface{}
+		update  map[string]interface{}
+		created string
+		updated string
+	}{
+		"recurring schedule": {
+			config: map[string]interface{}{
+				"scope":              "env:staging",
+				"monitor_identifier": []interface{}{map[string]interface{}{"monitor_tags": []interface{}{"*"}}},
+				"recurring_schedule": []interface{}{map[string]interface{}{
+					"recurrence": []interface{}{map[string]interface{}{"duration": "2h", "rrule": "FREQ=DAILY;INTERVAL=1", "start": "2023-03-01T09:00"}},
+				}},
+			},
+			update:  map[string]interface{}{"message": "updated"},
+			created: `{"display_timezone":"UTC","message":"","monitor_identifier":{"monitor_tags":["*"]},"mute_first_recovery_notification":false,"schedule":{"recurrences":[{"duration":"2h","rrule":"FREQ=DAILY;INTERVAL=1","start":"2023-03-01T09:00"}],"timezone":"UTC"},"scope":"env:staging"}`,
+			updated: `{"message":"updated"}`,
+		},
+		"one-time schedule without end": {
+			config: map[string]interface{}{
+				"scope":              "env:staging",
+				"monitor_identifier": []interface{}{map[string]interface{}{"monitor_id": 12345}},
+				"one_time_schedule":  []interface{}{map[string]interface{}{"start": "2023-03-01T09:00:00Z"}},
+				"notify_end_states":  []interface{}{"alert"},
+			},
+			update:  map[string]interface{}{"scope": "env:prod", "notify_end_states": []interface{}{"alert", "warn"}},
+			created: `{"display_timezone":"UTC","message":"","monitor_identifier":{"monitor_id":12345},"mute_first_recovery_notification":false,"notify_end_states":["alert"],"schedule":{"end":null,"start":"2023-03-01T09:00:00Z"},"scope":"env:staging"}`,
+			updated: `{"notify_end_states":["alert","warn"],"scope":"env:prod"}`,
+		},
+	}
+	for name, tc := range cases {
+		api := &fakeDowntimeScheduleAPI{}
+		providerConf := newFakeAPIProviderConfiguration(ctx, t, api)
+
+		d := schema.TestResourceDataRaw(t, downtimeScheduleResource.Schema, tc.config)
+		if diags := downtimeScheduleResource.CreateContext(ctx, d, providerConf); diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics %v", name, diags)
+		}
+
+		config := make(map[string]interface{})
+		for _, attributes := range []map[string]interface{}{tc.config, tc.update} {
+			for k, v := range attributes {
+				config[k] = v
+			}
+		}
+		diff, err := downtimeScheduleResource.Diff(ctx, d.State(), terraform.NewResourceConfigRaw(config), providerConf)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, diags := downtimeScheduleResource.Apply(ctx, d.State(), diff, providerConf); diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics %v", name, diags)
+		}
+
+		for i, expected := range []string{tc.created, tc.updated} {
+			var expectedAttributes map[string]interface{}
+			if err := json.Unmarshal([]byte(expected), &expectedAttributes); err != nil {
+				t.Fatal(err)
+			}
+			if i >= len(api.attributes) {
+				t.Errorf("%s: expected request %d to be sent", name, i)
+			} else if !reflect.DeepEqual(expectedAttributes, api.attributes[i]) {
+				actual, _ := json.Marshal(api.attributes[i])
+				t.Errorf("%s: expected request %d to send %s, got %s", name, i, expected, actual)
+			}
+		}
+	}
+}
@@ -50,7 +50,7 @@ func TestAccDatadogMonitorJSONBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDashboardExists(accProvider),
 					resource.TestCheckResourceAttr(
-						"datadog_monitor_json.monitor_json", "monitor", fmt.Sprintf("{\"message\":\"Change the message triggers if any host's clock goes out of sync with the time given by NTP. The offset threshold is configured in the Agent's 'ntp.yaml' file.\\n\\nSee [Troubleshooting NTP Offset issues](https://docs.datadoghq.com/agent/troubleshooting/ntp for more details on cause and resolution.\",\"name\":\"%s\",\"options\":{\"include_tags\":true,\"locked\":false,\"new_host_delay\":150,\"notify_audit\":false,\"notify_no_data\":false,\"thresholds\":{\"critical\":1,\"ok\":1,\"warning\":1}},\"priority\":null,\"query\":\"\\\"ntp.in_sync\\\".by(\\\"*\\\").last(2).count_by_status()\",\"tags\":[],\"type\":\"service check\"}", uniq)),
+						"datadog_monitor_json.monitor_json", "monitor", fmt.Sprintf("{\"message\":\"Change the message triggers if any host's clock goes out of sync with the time given by NTP. The offset threshold is configured in the Agent's 'ntp.yaml' file.\\n\\nSee [Troubleshooting NTP Offset issues](https://docs.datadoghq.com/agent/troubleshooting/ntp for more details on cause and resolution.\",\"name\":\"%s\",\"options\":{\"new_host_delay\":150,\"thresholds\":{\"critical\":1,\"ok\":1,\"warning\":1}},\"query\":\"\\\"ntp.in_sync\\\".by(\\\"*\\\").last(2).count_by_status()\",\"type\":\"service check\"}", uniq)),
 				),
 			},
 			{
@@ -58,7 +58,7 @@ func TestAccDatadogMonitorJSONBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					checkDashboardExists(accProvider),
 					resource.TestCheckResourceAttr(
-						"datadog_monitor_json.monitor_json", "monitor", fmt.Sprintf("{\"message\":\"Change the message triggers if any host's clock goes out of sync with the time given by NTP. The offset threshold is configured in the Agent's 'ntp.yaml' file.\\n\\nSee [Troubleshooting NTP Offset issues](https://docs.datadoghq.com/agent/troubleshooting/ntp for more details on cause and resolution.\",\"name\":\"%s\",\"options\":{\"include_tags\":true,\"locked\":false,\"new_host_delay\":150,\"notify_audit\":false,\"notify_no_data\":false,\"thresholds\":{\"critical\":1,\"ok\":1,\"warning\":1}},\"priority\":null,\"query\":\"\\\"ntp.in_sync\\\".by(\\\"*\\\").last(2).count_by_status()\",\"tags\":[],\"type\":\"service check\"}", uniqUpdated)),
+						"datadog_monitor_json.monitor_json", "monitor", fmt.Sprintf("{\"message\":\"Change the message triggers if any host's clock goes out of sync with the time given by NTP. The offset threshold is configured in the Agent's 'ntp.yaml' file.\\n\\nSee [Troubleshooting NTP Offset issues](https://docs.datadoghq.com/agent/troubleshooting/ntp for more details on cause and resolution.\",\"name\":\"%s\",\"options\":{\"new_host_delay\":150,\"thresholds\":{\"critical\":1,\"ok\":1,\"warning\":1}},\"query\":\"\\\"ntp.in_sync\\\".by(\\\"*\\\").last(2).count_by_status()\",\"type\":\"service check\"}", uniqUpdated)),
 				),
 			},
 		},
@@ -0,0 +1,137 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDatadogNotebookJSON_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		// Use testAccCheckDatadogNotebookDestroy() from the notebook resource
+		CheckDestroy: testAccCheckDatadogNotebookDestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogNotebookJSONConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogNotebookExists(accProvider),
+					resource.TestCheckResourceAttr(
+						"datadog_notebook_json.foo", "notebook", fmt.Sprintf("{\"cells\":[{\"attributes\":{\"definition\":{\"text\":\"## Summary\",\"type\":\"markdown\"}},\"type\":\"notebook_cells\"},{\"attributes\":{\"definition\":{\"requests\":[{\"display_type\":\"line\",\"q\":\"avg:system.cpu.user{*}\"}],\"type\":\"timeseries\"},\"graph_size\":\"m\"},\"type\":\"notebook_cells\"}],\"name\":\"%s\",\"time\":{\"live_span\":\"1h\"}}", uniq)),
+				),
+			},
+			{
+				Config: testAccCheckDatadogNotebookJSONConfigUpdated(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogNotebookExists(accProvider),
+					resource.TestCheckResourceAttr(
+						"datadog_notebook_json.foo", "notebook", fmt.Sprintf("{\"cells\":[{\"attributes\":{\"definition\":{\"text\":\"## Summary\\nUpdated\",\"type\":\"markdown\"}},\"type\":\"notebook_cells\"},{\"attributes\":{\"definition\":{\"requests\":[{\"display_type\":\"line\",\"q\":\"avg:system.cpu.user{*}\"}],\"type\":\"timeseries\"},\"graph_size\":\"m\",\"split_by\":{\"keys\":[\"env\"],\"tags\":[]},\"time\":{\"live_span\":\"4h\"}},\"type\":\"notebook_cells\"}],\"metadata\":{\"type\":\"runbook\"},\"name\":\"%s-updated\",\"time\":{\"live_span\":\"1h\"}}", uniq)),
+				),
+			},
+			{
+				ResourceName:      "datadog_notebook_json.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogNotebookJSONConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_notebook_json" "foo" {
+  notebook = <<EOF
+{
+  "name": "%s",
+  "status": "published",
+  "time": {
+    "live_span": "1h"
+  },
+  "cells": [
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "markdown",
+          "text": "## Summary"
+        }
+      }
+    },
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "timeseries",
+          "requests": [
+            {
+              "q": "avg:system.cpu.user{*}",
+              "display_type": "line"
+            }
+          ]
+        },
+        "graph_size": "m"
+      }
+    }
+  ]
+}
+EOF
+}`, uniq)
+}
+
+func testAccCheckDatadogNotebookJSONConfigUpdated(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_notebook_json" "foo" {
+  notebook = <<EOF
+{
+  "name": "%s-updated",
+  "time": {
+    "live_span": "1h"
+  },
+  "metadata": {
+    "type": "runbook"
+  },
+  "cells": [
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "markdown",
+          "text": "## Summary\nUpdated"
+        }
+      }
+    },
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "timeseries",
+          "requests": [
+            {
+              "q": "avg:system.cpu.user{*}",
+              "display_type": "line"
+            }
+          ]
+        },
+        "graph_size": "m",
+        "split_by": {
+          "keys": ["env"],
+          "tags": []
+        },
+        "time": {
+          "live_span": "4h"
+        }
+      }
+    }
+  ]
+}
+EOF
+}`, uniq)
+}
@@ -0,0 +1,191 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogNotebook_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy:      testAccCheckDatadogNotebookDestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogNotebookConfig(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogNotebookExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "status", "published"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "time.0.live_span", "1h"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "metadata.0.is_template", "false"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "metadata.0.take_snapshots", "false"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "metadata.0.type", ""),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.#", "2"),
+					resource.TestCheckResourceAttrSet("datadog_notebook.foo", "cell.0.id"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.0.markdown_definition.0.text", "## Summary"),
+					resource.TestCheckResourceAttrSet("datadog_notebook.foo", "cell.1.id"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.graph_size", "m"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.timeseries_definition.0.request.0.q", "avg:system.cpu.user{*}"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.split_by.#", "0"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.time.#", "0"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogNotebookConfigUpdated(uniq),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogNotebookExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "name", uniq+"-updated"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "status", "published"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "metadata.0.type", "postmortem"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.#", "3"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.0.markdown_definition.0.text", "## Summary\nUpdated"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.split_by.0.keys.0", "env"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.1.time.0.live_span", "4h"),
+					resource.TestCheckResourceAttrSet("datadog_notebook.foo", "cell.2.id"),
+					resource.TestCheckResourceAttr("datadog_notebook.foo", "cell.2.markdown_definition.0.text", "## Next steps"),
+				),
+			},
+			{
+				ResourceName:      "datadog_notebook.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogNotebookConfig(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_notebook" "foo" {
+  name = "%s"
+
+  time {
+    live_span = "1h"
+  }
+
+  cell {
+    markdown_definition {
+      text = "## Summary"
+    }
+  }
+
+  cell {
+    graph_size = "m"
+    timeseries_definition {
+      request {
+        q            = "avg:system.cpu.user{*}"
+        display_type = "line"
+      }
+    }
+  }
+}`, uniq)
+}
+
+func testAccCheckDatadogNotebookConfigUpdated(uniq string) string {
+	return fmt.Sprintf(`
+resource "datadog_notebook" "foo" {
+  name = "%s-updated"
+
+  time {
+    live_span = "1h"
+  }
+
+  metadata {
+    type = "postmortem"
+  }
+
+  cell {
+    markdown_definition {
+      text = "## Summary\nUpdated"
+    }
+  }
+
+  cell {
+    graph_size = "m"
+    timeseries_definition {
+      request {
+        q            = "avg:system.cpu.user{*}"
+        display_type = "line"
+      }
+    }
+    split_by {
+      keys = ["env"]
+      tags = []
+    }
+    time {
+      live_span = "4h"
+    }
+  }
+
+  cell {
+    markdown_definition {
+      text = "## Next steps"
+    }
+  }
+}`, uniq)
+}
+
+func testAccCheckDatadogNotebookExists(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_notebook" && r.Type != "datadog_notebook_json" {
+				continue
+			}
+			id, err := strconv.ParseInt(r.Primary.ID, 10, 64)
+			if err != nil {
+				return err
+			}
+			if _, _, err := apiInstances.GetNotebooksApiV1().GetNotebook(auth, id); err != nil {
+				return fmt.Errorf("received an error retrieving notebook %s", err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogNotebookDestroy(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_notebook" && r.Type != "datadog_notebook_json" {
+				continue
+			}
+			id, err := strconv.ParseInt(r.Primary.ID, 10, 64)
+			if err != nil {
+				return err
+			}
+			_, httpResp, err := apiInstances.GetNotebooksApiV1().GetNotebook(auth, id)
+			if err != nil {
+				if httpResp != nil && httpResp.StatusCode == 404 {
+					continue
+				}
+				return fmt.Errorf("received an error retrieving notebook %s", err)
+			}
+			return fmt.Errorf("notebook still exists")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,232 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogServiceLevelObjectiveAlert_Basic(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	// The monitor of the error budget alert, deleted outside of Terraform then when its block is removed
+	var errorBudgetMonitorID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDatadogServiceLevelObjectiveAlertDestroy(accProvider),
+			testAccCheckDatadogServiceLevelObjectiveResourceDestroy(accProvider),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveAlertConfig(uniq, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveAlertExists(accProvider),
+					resource.TestCheckResourceAttrPair("datadog_service_level_objective_alert.foo", "slo_id", "datadog_service_level_objective.foo", "id"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "timeframe", "30d"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "name", uniq),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "priority", "3"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "tags.#", "2"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "burn_rate.#", "1"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "burn_rate.0.long_window", "1h"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "burn_rate.0.short_window", "5m"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "burn_rate.0.threshold", "14.4"),
+					resource.TestCheckResourceAttrSet("datadog_service_level_objective_alert.foo", "burn_rate.0.monitor_id"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "error_budget.#", "1"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "error_budget.0.threshold", "50"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_alert.foo", "error_budget.0.monitor_id", func(v string) error {
+						errorBudgetMonitorID = v
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "datadog_service_level_objective_alert.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The validation of the alerts is only done during plan
+				ImportStateVerifyIgnore: []string{"validate"},
+			},
+			{
+				// Deleting a monitor outside of Terraform removes its block from the state, the monitor is created again
+				Config:             testAccCheckDatadogServiceLevelObjectiveAlertConfig(uniq, true),
+				Check:              testAccDeleteDatadogServiceLevelObjectiveAlertMonitor(accProvider, &errorBudgetMonitorID),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveAlertConfig(uniq, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveAlertExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "error_budget.#", "1"),
+					resource.TestCheckResourceAttrWith("datadog_service_level_objective_alert.foo", "error_budget.0.monitor_id", func(v string) error {
+						if v == errorBudgetMonitorID {
+							return fmt.Errorf("expected a new error budget monitor, got %s", v)
+						}
+						errorBudgetMonitorID = v
+						return nil
+					}),
+				),
+			},
+			{
+				// Removing a block deletes its monitor
+				Config: testAccCheckDatadogServiceLevelObjectiveAlertConfig(uniq, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogServiceLevelObjectiveAlertExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "burn_rate.#", "1"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective_alert.foo", "error_budget.#", "0"),
+					testAccCheckDatadogServiceLevelObjectiveAlertMonitorDeleted(accProvider, &errorBudgetMonitorID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogServiceLevelObjectiveAlertConfig(uniq string, errorBudget bool) string {
+	errorBudgetBlock := ""
+	if errorBudget {
+		errorBudgetBlock = `
+  error_budget {
+    threshold = 50
+  }`
+	}
+	return fmt.Sprintf(`
+resource "datadog_service_level_objective" "foo" {
+  name        = "%s"
+  type        = "metric"
+  description = "SLO with alerts"
+  query {
+    numerator   = "sum:my.metric{type:good}.as_count()"
+    denominator = "sum:my.metric{*}.as_count()"
+  }
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.5
+  }
+
+  thresholds {
+    timeframe = "30d"
+    target    = 99
+  }
+}
+
+resource "datadog_service_level_objective_alert" "foo" {
+  slo_id    = datadog_service_level_objective.foo.id
+  timeframe = "30d"
+  name      = "%s"
+  message   = "The error budget of the SLO is burning"
+  priority  = 3
+  tags      = ["foo:bar", "baz"]
+
+  burn_rate {
+    long_window  = "1h"
+    short_window = "5m"
+    threshold    = 14.4
+  }
+%s
+}`, uniq, uniq, errorBudgetBlock)
+}
+
+// sloAlertMonitorExists returns whether the monitor with the given ID exists
+func sloAlertMonitorExists(accProvider func() (*schema.Provider, error), id string) (bool, error) {
+	provider, _ := accProvider()
+	providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+	apiInstances := providerConf.DatadogApiInstances
+	auth := providerConf.Auth
+
+	i, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, err
+	}
+	if _, httpResp, err := apiInstances.GetMonitorsApiV1().GetMonitor(auth, i); err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("received an error retrieving monitor %s: %s", id, err)
+	}
+	return true, nil
+}
+
+func testAccCheckDatadogServiceLevelObjectiveAlertExists(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_service_level_objective_alert" {
+				continue
+			}
+			for _, id := range strings.Split(r.Primary.ID, ",") {
+				exists, err := sloAlertMonitorExists(accProvider, id)
+				if err != nil {
+					return err
+				}
+				if !exists {
+					return fmt.Errorf("monitor %s of service level objective alert %s doesn't exist", id, r.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogServiceLevelObjectiveAlertMonitorDeleted(accProvider func() (*schema.Provider, error), id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		exists, err := sloAlertMonitorExists(accProvider, *id)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("monitor %s still exists", *id)
+		}
+		return nil
+	}
+}
+
+func testAccDeleteDatadogServiceLevelObjectiveAlertMonitor(accProvider func() (*schema.Provider, error), id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		i, err := strconv.ParseInt(*id, 10, 64)
+		if err != nil {
+			return err
+		}
+		if _, _, err := apiInstances.GetMonitorsApiV1().DeleteMonitor(auth, i); err != nil {
+			return fmt.Errorf("received an error deleting monitor %s: %s", *id, err)
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogServiceLevelObjectiveAlertDestroy(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_service_level_objective_alert" {
+				continue
+			}
+			for _, id := range strings.Split(r.Primary.ID, ",") {
+				exists, err := sloAlertMonitorExists(accProvider, id)
+				if err != nil {
+					return err
+				}
+				if exists {
+					return fmt.Errorf("monitor %s of service level objective alert %s still exists", id, r.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
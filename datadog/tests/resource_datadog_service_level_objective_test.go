@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-datadog/datadog"
@@ -53,6 +54,55 @@ resource "datadog_service_level_objective" "foo" {
 }`, uniq)
 }
 
+func testAccCheckDatadogServiceLevelObjectiveTimeSliceConfig(uniq string, comparator string, threshold float64) string {
+	return fmt.Sprintf(`
+resource "datadog_service_level_objective" "foo" {
+  name        = "%s"
+  type        = "time_slice"
+  description = "some description about foo time slice SLO"
+
+  sli_specification {
+    time_slice {
+      comparator             = "%s"
+      threshold              = %v
+      query_interval_seconds = 300
+
+      query {
+        formula {
+          formula_expression = "query1"
+        }
+        query {
+          metric_query {
+            name  = "query1"
+            query = "p95:trace.http.request.duration{service:web}"
+          }
+        }
+      }
+    }
+  }
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.9
+    warning   = 99.99
+  }
+
+  timeframe         = "7d"
+  target_threshold  = 99.9
+  warning_threshold = 99.99
+
+  tags = ["foo:bar", "baz"]
+}
+
+data "datadog_service_level_objective" "foo" {
+  id = datadog_service_level_objective.foo.id
+}
+
+data "datadog_service_level_objectives" "foo" {
+  ids = [datadog_service_level_objective.foo.id]
+}`, uniq, comparator, threshold)
+}
+
 func testAccCheckDatadogServiceLevelObjectiveInvalidMonitorConfig(uniq string) string {
 	return fmt.Sprintf(`
 resource "datadog_service_level_objective" "bar" {
@@ -227,6 +277,51 @@ func TestAccDatadogServiceLevelObjective_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDatadogServiceLevelObjective_TimeSlice(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	sloName := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy:      testAccCheckDatadogServiceLevelObjectiveResourceDestroy(accProvider),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveTimeSliceConfig(sloName, "<=", 0.5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "type", "time_slice"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.comparator", "<="),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.threshold", "0.5"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query_interval_seconds", "300"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.formula.0.formula_expression", "query1"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.0.name", "query1"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.query.0.query.0.metric_query.0.query", "p95:trace.http.request.duration{service:web}"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "thresholds.0.target", "99.9"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective.foo", "name", sloName),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objective.foo", "type", "time_slice"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objectives.foo", "slos.#", "1"),
+					resource.TestCheckResourceAttr("data.datadog_service_level_objectives.foo", "slos.0.type", "time_slice"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogServiceLevelObjectiveTimeSliceConfig(sloName, ">", 0.25),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "type", "time_slice"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.comparator", ">"),
+					resource.TestCheckResourceAttr("datadog_service_level_objective.foo", "sli_specification.0.time_slice.0.threshold", "0.25"),
+				),
+			},
+			{
+				ResourceName:      "datadog_service_level_objective.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccDatadogServiceLevelObjective_InvalidMonitor(t *testing.T) {
 	t.Parallel()
 	ctx, accProviders := testAccProviders(context.Background(), t)
@@ -261,6 +356,29 @@ func testAccCheckDatadogServiceLevelObjectiveDestroy(accProvider func() (*schema
 	}
 }
 
+// testAccCheckDatadogServiceLevelObjectiveResourceDestroy only checks the `datadog_service_level_objective`
+// resources, e.g. to ignore the data sources and the resources of the other types of the configuration
+func testAccCheckDatadogServiceLevelObjectiveResourceDestroy(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		apiInstances := providerConf.DatadogApiInstances
+		auth := providerConf.Auth
+
+		for k, r := range s.RootModule().Resources {
+			if r.Type != "datadog_service_level_objective" || strings.HasPrefix(k, "data.") {
+				continue
+			}
+			if _, httpResp, err := apiInstances.GetServiceLevelObjectivesApiV1().GetSLO(auth, r.Primary.ID); err == nil {
+				return fmt.Errorf("service level objective %s still exists", r.Primary.ID)
+			} else if httpResp == nil || httpResp.StatusCode != 404 {
+				return fmt.Errorf("received an error retrieving service level objective %s: %s", r.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
+
 func destroyServiceLevelObjectiveHelper(ctx context.Context, s *terraform.State, apiInstances *utils.ApiInstances) error {
 	err := utils.Retry(2, 5, func() error {
 		for _, r := range s.RootModule().Resources {
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog"
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDatadogSharedDashboard_Invite(t *testing.T) {
+	t.Parallel()
+	ctx, accProviders := testAccProviders(context.Background(), t)
+	uniq := uniqueEntityName(ctx, t)
+	accProvider := testAccProvider(t, accProviders)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: accProviders,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckDatadogSharedDashboardDestroy(accProvider),
+			// Use checkDashboardDestroy() from the dashboard resource
+			checkDashboardDestroy(accProvider),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDatadogSharedDashboardInviteConfig(uniq, `"frog@datadoghq.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSharedDashboardExists(accProvider),
+					resource.TestCheckResourceAttrPair("datadog_shared_dashboard.foo", "dashboard_id", "datadog_dashboard.foo", "id"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "share_type", "invite"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "share_list.#", "1"),
+					resource.TestCheckTypeSetElemAttr("datadog_shared_dashboard.foo", "share_list.*", "frog@datadoghq.com"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "global_time.0.live_span", "1h"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "global_time_selectable", "true"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "expiration", "2050-01-01T00:00:00+00:00"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "invitee.#", "1"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "invitee.0.email", "frog@datadoghq.com"),
+					resource.TestCheckResourceAttrSet("datadog_shared_dashboard.foo", "token"),
+					resource.TestCheckResourceAttrSet("datadog_shared_dashboard.foo", "public_url"),
+				),
+			},
+			{
+				Config: testAccCheckDatadogSharedDashboardInviteConfig(uniq, `"frog@datadoghq.com", "toad@datadoghq.com"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDatadogSharedDashboardExists(accProvider),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "share_list.#", "2"),
+					resource.TestCheckTypeSetElemAttr("datadog_shared_dashboard.foo", "share_list.*", "frog@datadoghq.com"),
+					resource.TestCheckTypeSetElemAttr("datadog_shared_dashboard.foo", "share_list.*", "toad@datadoghq.com"),
+					resource.TestCheckResourceAttr("datadog_shared_dashboard.foo", "invitee.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "datadog_shared_dashboard.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckDatadogSharedDashboardInviteConfig(uniq string, shareList string) string {
+	return fmt.Sprintf(`
+resource "datadog_dashboard" "foo" {
+  title       = "%s"
+  layout_type = "ordered"
+
+  widget {
+    note_definition {
+      content = "Shared with the vendors"
+    }
+  }
+}
+
+resource "datadog_shared_dashboard" "foo" {
+  dashboard_id = datadog_dashboard.foo.id
+  share_type   = "invite"
+  share_list   = [%s]
+  expiration   = "2050-01-01T00:00:00Z"
+
+  global_time {
+    live_span = "1h"
+  }
+  global_time_selectable = true
+}`, uniq, shareList)
+}
+
+func testAccCheckDatadogSharedDashboardExists(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		httpClient := providerConf.DatadogApiInstances.HttpClient
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_shared_dashboard" {
+				continue
+			}
+			if _, _, err := utils.SendRequest(auth, httpClient, "GET", "/api/v1/dashboard/public/"+r.Primary.ID, nil); err != nil {
+				return fmt.Errorf("received an error retrieving shared dashboard %s", err)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckDatadogSharedDashboardDestroy(accProvider func() (*schema.Provider, error)) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		provider, _ := accProvider()
+		providerConf := provider.Meta().(*datadog.ProviderConfiguration)
+		httpClient := providerConf.DatadogApiInstances.HttpClient
+		auth := providerConf.Auth
+
+		for _, r := range s.RootModule().Resources {
+			if r.Type != "datadog_shared_dashboard" {
+				continue
+			}
+			_, httpResp, err := utils.SendRequest(auth, httpClient, "GET", "/api/v1/dashboard/public/"+r.Primary.ID, nil)
+			if err != nil {
+				if httpResp != nil && httpResp.StatusCode == 404 {
+					continue
+				}
+				return fmt.Errorf("received an error retrieving shared dashboard %s", err)
+			}
+			return fmt.Errorf("shared dashboard still exists")
+		}
+		return nil
+	}
+}
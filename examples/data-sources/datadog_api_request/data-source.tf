@@ -0,0 +1,11 @@
+data "datadog_api_request" "roles" {
+  path = "/api/v2/roles"
+  query = {
+    "filter"     = "Datadog"
+    "page[size]" = "100"
+  }
+}
+
+output "role_names" {
+  value = [for role in jsondecode(data.datadog_api_request.roles.response_body).data : role.attributes.name]
+}
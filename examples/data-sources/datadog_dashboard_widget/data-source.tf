@@ -0,0 +1,28 @@
+# Define a widget once, e.g. in a module shared by several dashboards
+data "datadog_dashboard_widget" "cpu" {
+  timeseries_definition {
+    title = "CPU usage"
+    request {
+      q            = "avg:system.cpu.user{$env} by {host}"
+      display_type = "line"
+    }
+  }
+}
+
+resource "datadog_dashboard" "service" {
+  title       = "Service dashboard"
+  layout_type = "ordered"
+
+  template_variable {
+    name   = "env"
+    prefix = "env"
+  }
+
+  widget {
+    note_definition {
+      content = "Service overview"
+    }
+  }
+
+  widget_json = [data.datadog_dashboard_widget.cpu.json]
+}
@@ -0,0 +1,15 @@
+data "datadog_service_level_objective_history" "checkout" {
+  slo_id = "12345678901234567890123456789012"
+  window = "7d"
+  groups = ["env:prod"]
+}
+
+# Block the promotion once the error budget of the last 7 days is exhausted
+resource "terraform_data" "promotion_gate" {
+  lifecycle {
+    precondition {
+      condition     = data.datadog_service_level_objective_history.checkout.error_budget_remaining > 0
+      error_message = "The checkout SLO error budget is exhausted."
+    }
+  }
+}
@@ -0,0 +1,17 @@
+# Manage a dashboard list through the generic v1 dashboard lists endpoint
+resource "datadog_api_object" "dashboard_list" {
+  create_path = "/api/v1/dashboard/lists/manual"
+  ignore_paths = [
+    "/id",
+    "/author",
+    "/created",
+    "/modified",
+    "/dashboard_count",
+    "/is_favorite",
+    "/type",
+  ]
+
+  body = jsonencode({
+    name = "Example dashboard list"
+  })
+}
@@ -1,2 +1,4 @@
 terraform import datadog_dashboard.my_service_dashboard sv7-gyh-kas
 
+# Dashboards can also be imported by exact title, as long as exactly one dashboard matches
+terraform import datadog_dashboard.my_service_dashboard "title=My service dashboard"
@@ -0,0 +1,2 @@
+# Dashboard list items can be imported using their dashboard list ID and dashboard ID separated with a colon (`:`).
+terraform import datadog_dashboard_list_item.service "123456:abc-def-ghi"
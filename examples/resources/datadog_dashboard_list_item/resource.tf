@@ -0,0 +1,9 @@
+# Add a dashboard managed in another module to a shared dashboard list
+data "datadog_dashboard_list" "team" {
+  name = "Team dashboards"
+}
+
+resource "datadog_dashboard_list_item" "service" {
+  list_id      = data.datadog_dashboard_list.team.id
+  dashboard_id = datadog_dashboard.service.id
+}
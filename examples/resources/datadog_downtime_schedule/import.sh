@@ -0,0 +1 @@
+terraform import datadog_downtime_schedule.weekly_maintenance 00000000-0000-1234-0000-000000000000
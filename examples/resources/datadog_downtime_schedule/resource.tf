@@ -0,0 +1,31 @@
+# Silence the web monitors for two hours every Sunday night
+resource "datadog_downtime_schedule" "weekly_maintenance" {
+  scope = "env:prod AND service:web"
+  monitor_identifier {
+    monitor_tags = ["team:web"]
+  }
+  recurring_schedule {
+    recurrence {
+      rrule    = "FREQ=WEEKLY;BYDAY=SU"
+      duration = "2h"
+      start    = "2023-03-05T22:00:00"
+    }
+    timezone = "Europe/Paris"
+  }
+  display_timezone  = "Europe/Paris"
+  message           = "Weekly maintenance @web-team"
+  notify_end_states = ["alert", "warn"]
+  notify_end_types  = ["expired"]
+}
+
+# Silence a single monitor during a migration
+resource "datadog_downtime_schedule" "migration" {
+  scope = "env:prod"
+  monitor_identifier {
+    monitor_id = 12345
+  }
+  one_time_schedule {
+    start = "2023-03-10T08:00:00Z"
+    end   = "2023-03-10T12:00:00Z"
+  }
+}
@@ -1,2 +1,5 @@
 terraform import datadog_monitor.bytes_received_localhost 2081
 
+# Monitors can also be imported by exact name or by monitor search query, as long as exactly one monitor matches
+terraform import datadog_monitor.bytes_received_localhost "name=Bytes received on localhost"
+terraform import datadog_monitor.bytes_received_localhost "query=tag:service:localhost type:metric"
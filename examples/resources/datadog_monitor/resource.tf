@@ -14,4 +14,38 @@ resource "datadog_monitor" "foo" {
   include_tags = true
 
   tags = ["foo:bar", "team:fooBar"]
-}
\ No newline at end of file
+}
+
+# Build the message from per-transition recipients
+resource "datadog_monitor" "cpu" {
+  name  = "CPU usage is high on {{host.name}}"
+  type  = "metric alert"
+  query = "avg(last_5m):avg:system.cpu.user{env:prod} by {host} > 90"
+
+  notification {
+    header = "CPU usage is {{value}}% on {{host.name}}."
+
+    alert {
+      text       = "Check the runbook before paging the owner."
+      recipients = ["@slack-prod-alerts", "@pagerduty-infra"]
+    }
+
+    warning {
+      recipients = ["@slack-prod-alerts"]
+    }
+
+    recovery {
+      recipients = ["@slack-prod-alerts", "@pagerduty-infra"]
+    }
+
+    escalation {
+      text       = "CPU usage is still high."
+      recipients = ["@pagerduty-infra"]
+    }
+  }
+
+  monitor_thresholds {
+    warning  = 80
+    critical = 90
+  }
+}
@@ -0,0 +1 @@
+terraform import datadog_notebook.postmortem 123456
@@ -0,0 +1,45 @@
+# Create a new Datadog notebook
+resource "datadog_notebook" "postmortem" {
+  name   = "Postmortem: checkout latency"
+  status = "published"
+
+  time {
+    live_span = "1w"
+  }
+
+  metadata {
+    type = "postmortem"
+  }
+
+  cell {
+    markdown_definition {
+      text = "## Summary\nCheckout latency increased after the 4.2 release."
+    }
+  }
+
+  cell {
+    graph_size = "m"
+    timeseries_definition {
+      request {
+        q            = "avg:trace.http.request.duration{service:checkout}"
+        display_type = "line"
+      }
+    }
+    split_by {
+      keys = ["env"]
+      tags = []
+    }
+  }
+
+  cell {
+    log_stream_definition {
+      indexes = ["main"]
+      query   = "service:checkout status:error"
+      columns = ["core_host", "core_service"]
+    }
+    time {
+      start = "2023-01-10T09:00:00Z"
+      end   = "2023-01-10T12:00:00Z"
+    }
+  }
+}
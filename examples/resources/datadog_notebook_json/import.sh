@@ -0,0 +1 @@
+terraform import datadog_notebook_json.runbook 123456
@@ -0,0 +1,40 @@
+resource "datadog_notebook_json" "runbook" {
+  notebook = <<EOF
+{
+  "name": "Checkout runbook",
+  "status": "published",
+  "time": {
+    "live_span": "1h"
+  },
+  "metadata": {
+    "type": "runbook"
+  },
+  "cells": [
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "markdown",
+          "text": "## Restarting the checkout service"
+        }
+      }
+    },
+    {
+      "type": "notebook_cells",
+      "attributes": {
+        "definition": {
+          "type": "timeseries",
+          "requests": [
+            {
+              "q": "avg:system.cpu.user{service:checkout}",
+              "display_type": "line"
+            }
+          ]
+        },
+        "graph_size": "m"
+      }
+    }
+  ]
+}
+EOF
+}
@@ -55,3 +55,44 @@ resource "datadog_service_level_objective" "bar" {
 
   tags = ["foo:bar", "baz"]
 }
+
+
+# Time-Slice SLO
+# Create a new Datadog service level objective counting the minutes where the p95 latency is under 500ms
+resource "datadog_service_level_objective" "baz" {
+  name        = "Example Time Slice SLO"
+  type        = "time_slice"
+  description = "My custom time slice SLO"
+
+  sli_specification {
+    time_slice {
+      comparator             = "<="
+      threshold              = 0.5
+      query_interval_seconds = 300
+
+      query {
+        formula {
+          formula_expression = "query1"
+        }
+        query {
+          metric_query {
+            name  = "query1"
+            query = "p95:trace.http.request.duration{service:web}"
+          }
+        }
+      }
+    }
+  }
+
+  thresholds {
+    timeframe = "7d"
+    target    = 99.9
+    warning   = 99.99
+  }
+
+  timeframe         = "7d"
+  target_threshold  = 99.9
+  warning_threshold = 99.99
+
+  tags = ["foo:bar", "baz"]
+}
@@ -0,0 +1,2 @@
+# Service level objective alerts can be imported using the comma-separated IDs of their monitors
+terraform import datadog_service_level_objective_alert.latency 12345678,12345679
@@ -0,0 +1,37 @@
+resource "datadog_service_level_objective" "latency" {
+  name = "Checkout latency"
+  type = "metric"
+  query {
+    numerator   = "sum:checkout.requests.fast{*}.as_count()"
+    denominator = "sum:checkout.requests{*}.as_count()"
+  }
+  thresholds {
+    timeframe = "30d"
+    target    = 99.9
+  }
+}
+
+# Multiwindow burn rate alerts, and an alert once 75% of the error budget is consumed
+resource "datadog_service_level_objective_alert" "latency" {
+  slo_id    = datadog_service_level_objective.latency.id
+  timeframe = "30d"
+  name      = "Checkout latency SLO"
+  message   = "The checkout latency error budget is burning fast. @pagerduty-checkout"
+  tags      = ["team:checkout"]
+
+  burn_rate {
+    long_window  = "1h"
+    short_window = "5m"
+    threshold    = 14.4
+  }
+
+  burn_rate {
+    long_window  = "6h"
+    short_window = "30m"
+    threshold    = 6
+  }
+
+  error_budget {
+    threshold = 75
+  }
+}
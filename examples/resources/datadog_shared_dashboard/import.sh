@@ -0,0 +1,2 @@
+# Shared dashboards can be imported using their share token.
+terraform import datadog_shared_dashboard.vendor abcdefghijklmnopqrstuvwxyz012345
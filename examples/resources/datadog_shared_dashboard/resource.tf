@@ -0,0 +1,19 @@
+# Share a dashboard with a list of invitees
+resource "datadog_shared_dashboard" "vendor" {
+  dashboard_id = datadog_dashboard.ordered_dashboard.id
+  share_type   = "invite"
+  share_list   = ["jane.doe@example.com", "john.doe@example.com"]
+  expiration   = "2024-06-30T00:00:00Z"
+
+  global_time {
+    live_span = "1d"
+  }
+  global_time_selectable = true
+
+  selectable_template_var {
+    name          = "env"
+    prefix        = "env"
+    default_value = "prod"
+    visible_tags  = ["prod", "staging"]
+  }
+}
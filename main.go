@@ -3,7 +3,10 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
@@ -11,6 +14,13 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export(os.Args[2:]); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	var debugMode bool
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
@@ -28,3 +38,34 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+// export writes the existing Datadog objects as Terraform configuration, using the same credentials
+// environment variables as the provider, e.g. DD_API_KEY and DD_APP_KEY.
+func export(args []string) error {
+	var types, tags, output string
+
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	flags.StringVar(&types, "types", "", fmt.Sprintf("comma-separated resource types to export, among %s", strings.Join(datadog.ExportResourceTypes(), ", ")))
+	flags.StringVar(&tags, "tags", "", "comma-separated tags the exported objects must all have, e.g. team:web,env:prod")
+	flags.StringVar(&output, "output", "", "file to write the configuration to, defaults to the standard output")
+	flags.Parse(args)
+
+	opts := datadog.ExportOptions{}
+	if types != "" {
+		opts.ResourceTypes = strings.Split(types, ",")
+	}
+	if tags != "" {
+		opts.Tags = strings.Split(tags, ",")
+	}
+
+	w := os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return datadog.Export(context.Background(), w, opts)
+}